@@ -0,0 +1,128 @@
+package builderutil
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+)
+
+// Recover adapts an infallible mutation into the func(*T) error shape used by Lister,
+// removing the "return nil" boilerplate from simple setters. The returned function calls
+// fn and always returns nil. A nil fn yields a function that does nothing.
+// Parameters:
+// - fn: The mutation to adapt.
+//
+// Returns:
+// - A configuration function that applies fn and never fails.
+func Recover[T any](fn func(*T)) func(*T) error {
+	return func(t *T) error {
+
+		if fn != nil {
+			fn(t)
+		}
+
+		return nil
+	}
+}
+
+// Must is the inverse of Recover: it adapts a fallible configuration function into an
+// infallible mutation of shape func(*T). Because the result cannot report errors, it
+// panics if fn fails; the panic value is an error wrapping the original one, so it can be
+// inspected with errors.Is or errors.As after recovering. A nil fn yields a function that
+// does nothing.
+// Parameters:
+// - fn: The configuration function to adapt.
+//
+// Returns:
+// - A mutation that applies fn and panics if it returns an error.
+func Must[T any](fn func(*T) error) func(*T) {
+	return func(t *T) {
+
+		if fn == nil {
+			return
+		}
+
+		if err := fn(t); err != nil {
+			panic(fmt.Errorf("builderutil: Must failed: %w", err))
+		}
+	}
+}
+
+// WithContext adapts a context-aware configuration function into the standard option shape
+// by capturing ctx, so options that perform I/O can live in the same Lister as plain ones.
+// The returned function fails with the context error without calling fn if ctx is already
+// done when it is applied; otherwise fn receives ctx unchanged.
+// Parameters:
+// - ctx: The context passed to fn.
+// - fn: The context-aware configuration function to adapt.
+//
+// Returns:
+// - A configuration function calling fn with ctx.
+func WithContext[T any](ctx context.Context, fn func(context.Context, *T) error) func(*T) error {
+	return func(t *T) error {
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return fn(ctx, t)
+	}
+}
+
+// Checkpoint returns a configuration function that does nothing but fail with the context
+// error once ctx is done. Inserted between expensive options, it acts as a cancellation point
+// for builds that do not check their context themselves, such as Build or BuildFrom.
+// Parameters:
+// - ctx: The context to check.
+//
+// Returns:
+// - A configuration function returning ctx.Err().
+func Checkpoint[T any](ctx context.Context) func(*T) error {
+	return func(*T) error {
+		return ctx.Err()
+	}
+}
+
+// WithRand adapts a configuration function that consumes randomness, such as one generating
+// an ID, so that its random source is injected. Supplying a source with a fixed seed makes
+// builds reproducible in tests. A single *rand.Rand is created from src when WithRand is
+// called and reused every time the option is applied, so the option is not safe for
+// concurrent builds.
+// Parameters:
+// - src: The source of randomness.
+// - fn: The configuration function receiving the *rand.Rand.
+//
+// Returns:
+// - A configuration function calling fn with a *rand.Rand backed by src.
+func WithRand[T any](src rand.Source, fn func(*T, *rand.Rand) error) func(*T) error {
+
+	r := rand.New(src)
+
+	return func(t *T) error {
+		return fn(t, r)
+	}
+}
+
+// WithDeepCopy adapts fn so that it runs on a target whose data is no longer shared with
+// anything else. Before fn is called, the target is replaced by a deep copy of itself: its
+// pointers, slices, maps, arrays, interfaces and exported struct fields are copied
+// recursively, so in-place mutations of them cannot leak into the template of BuildFrom or
+// into values captured elsewhere. Channels, functions and unexported fields are still shared.
+// The copy has a reflection cost on every call, so this is an opt-in safety wrapper.
+// Parameters:
+// - fn: The configuration function to isolate.
+//
+// Returns:
+// - A configuration function that deep-copies the target and then calls fn.
+func WithDeepCopy[T any](fn func(*T) error) func(*T) error {
+	return func(t *T) error {
+
+		cloneInto(t, t)
+
+		if fn == nil {
+			return nil
+		}
+
+		return fn(t)
+	}
+}
@@ -0,0 +1,225 @@
+package builderutil_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestRecover tests if Recover adapts an infallible mutation that applies correctly and never errors.
+func TestRecover(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	setValue := builderutil.Recover(func(c *Config) {
+		c.Value = 42
+	})
+
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{setValue, builderutil.Recover[Config](nil)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// TestMust_Success tests if Must applies a configuration function that succeeds.
+func TestMust_Success(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	setValue := builderutil.Must(func(c *Config) error {
+		c.Value = 42
+		return nil
+	})
+
+	config := &Config{}
+	setValue(config)
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// TestMust_Panic tests if Must panics with an error wrapping the failure of the configuration function.
+func TestMust_Panic(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	failing := builderutil.Must(func(*Config) error {
+		return errFailed
+	})
+
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, errFailed) {
+			t.Fatalf("Expected panic wrapping %v, got %v", errFailed, err)
+		}
+	}()
+
+	failing(&Config{})
+}
+
+// TestWithContext tests if the captured context is passed through to the adapted function.
+func TestWithContext(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, 42)
+
+	setFromContext := func(ctx context.Context, c *Config) error {
+		c.Value = ctx.Value(key{}).(int)
+		return nil
+	}
+
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{builderutil.WithContext(ctx, setFromContext)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// TestWithContext_Cancelled tests if a cancelled context makes the adapted function fail without calling it.
+func TestWithContext_Cancelled(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	fn := func(context.Context, *Config) error {
+		called = true
+		return nil
+	}
+
+	err := builderutil.WithContext(ctx, fn)(&Config{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	if called {
+		t.Error("Expected the function not to be called")
+	}
+}
+
+// TestCheckpoint tests if Checkpoint is a no-op for a live context and stops a cancelled build.
+func TestCheckpoint(t *testing.T) {
+	type Config struct {
+		Steps []string
+	}
+
+	step := func(name string) func(*Config) error {
+		return func(c *Config) error {
+			c.Steps = append(c.Steps, name)
+			return nil
+		}
+	}
+
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{
+		step("a"), builderutil.Checkpoint[Config](context.Background()), step("b"),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(config.Steps) != 2 {
+		t.Errorf("Expected 2 steps, got %v", config.Steps)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancelFunc := func(c *Config) error {
+		cancel()
+		return nil
+	}
+
+	var ran bool
+	after := func(*Config) error {
+		ran = true
+		return nil
+	}
+
+	_, err = builderutil.Build[Config](builderutil.Options[Config]{
+		step("a"), cancelFunc, builderutil.Checkpoint[Config](ctx), after,
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if ran {
+		t.Errorf("Expected options after the checkpoint not to run")
+	}
+}
+
+// TestWithRand tests if two builds with the same seed produce identical results.
+func TestWithRand(t *testing.T) {
+	type Config struct {
+		ID int64
+	}
+
+	setID := func(c *Config, r *rand.Rand) error {
+		c.ID = r.Int63()
+		return nil
+	}
+
+	build := func(seed int64) *Config {
+		config, err := builderutil.Build[Config](builderutil.Options[Config]{builderutil.WithRand(rand.NewSource(seed), setID)})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return config
+	}
+
+	first, second := build(42), build(42)
+	if first.ID != second.ID {
+		t.Errorf("Expected identical IDs for the same seed, got %d and %d", first.ID, second.ID)
+	}
+
+	if other := build(7); other.ID == first.ID {
+		t.Errorf("Expected a different ID for a different seed, got %d twice", other.ID)
+	}
+}
+
+// TestWithDeepCopy tests if mutating a slice through the wrapped option leaves the template intact.
+func TestWithDeepCopy(t *testing.T) {
+	type Config struct {
+		Tags   []string
+		Labels map[string]string
+	}
+
+	template := Config{Tags: []string{"a", "b"}, Labels: map[string]string{"env": "prod"}}
+
+	mutate := func(c *Config) error {
+		c.Tags[0] = "changed"
+		c.Labels["env"] = "changed"
+		return nil
+	}
+
+	config, err := builderutil.BuildFrom(template, builderutil.Options[Config]{builderutil.WithDeepCopy(mutate)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Tags[0] != "changed" || config.Labels["env"] != "changed" {
+		t.Errorf("Expected the built instance to be mutated, got %+v", *config)
+	}
+
+	if template.Tags[0] != "a" || template.Labels["env"] != "prod" {
+		t.Errorf("Expected the template to be untouched, got %+v", template)
+	}
+}
@@ -0,0 +1,59 @@
+package builderutil
+
+import "context"
+
+// AuditEntry records the outcome of one configuration function applied during a build.
+type AuditEntry struct {
+	// ListerIndex is the index of the Lister that provided the function.
+	ListerIndex int
+	// FuncIndex is the index of the function within the Lister's List.
+	FuncIndex int
+	// Name is the name of the function if its Lister implements NamedLister, or empty.
+	Name string
+	// Err is the error returned by the function, or nil if it succeeded.
+	Err error
+}
+
+// BuildAudited is like BuildContext but also returns an audit trail of exactly which
+// configuration functions ran and whether they succeeded, for compliance logging.
+// Functions skipped because they are nil or because ctx was done have no entry.
+// Parameters:
+// - ctx: The context that controls cancellation of the build.
+// - opts: Variadic arguments of type Lister[T], optionally implementing NamedLister[T].
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - One entry per function that ran, in apply order, returned even on error.
+// - An error if the context is done or any configuration function fails.
+func BuildAudited[T any](ctx context.Context, opts ...Lister[T]) (*T, []AuditEntry, error) {
+
+	t := new(T)
+
+	var entries []AuditEntry
+	names := map[int][]string{}
+
+	err := apply(ctx, t, opts, func(i, j int, fn func(*T) error) error {
+
+		if _, ok := names[i]; !ok {
+			names[i] = nil
+			if named, ok := opts[i].(NamedLister[T]); ok {
+				names[i] = named.Names()
+			}
+		}
+
+		entry := AuditEntry{ListerIndex: i, FuncIndex: j}
+		if j < len(names[i]) {
+			entry.Name = names[i][j]
+		}
+
+		entry.Err = fn(t)
+		entries = append(entries, entry)
+
+		return entry.Err
+	})
+	if err != nil {
+		return nil, entries, err
+	}
+
+	return t, entries, nil
+}
@@ -0,0 +1,73 @@
+package builderutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildAudited tests if the audit trail records every applied function and the failing one.
+func TestBuildAudited(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	noop := func(*Config) error {
+		return nil
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	named := &namedOptions[Config]{
+		names: []string{"set-host", "set-port"},
+		funcs: []func(*Config) error{noop, errFunc},
+	}
+
+	_, entries, err := builderutil.BuildAudited[Config](context.Background(), builderutil.Options[Config]{noop, nil}, named)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 audit entries, got %d", len(entries))
+	}
+
+	expected := []builderutil.AuditEntry{
+		{ListerIndex: 0, FuncIndex: 0},
+		{ListerIndex: 1, FuncIndex: 0, Name: "set-host"},
+		{ListerIndex: 1, FuncIndex: 1, Name: "set-port", Err: errFailed},
+	}
+	for i, entry := range entries {
+		if entry != expected[i] {
+			t.Errorf("Expected entry %d to be %+v, got %+v", i, expected[i], entry)
+		}
+	}
+}
+
+// TestBuildAudited_Cancelled tests if a cancelled context yields no entries.
+func TestBuildAudited_Cancelled(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	noop := func(*Config) error {
+		return nil
+	}
+
+	_, entries, err := builderutil.BuildAudited[Config](ctx, builderutil.Options[Config]{noop})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	if len(entries) != 0 {
+		t.Errorf("Expected no audit entries, got %v", entries)
+	}
+}
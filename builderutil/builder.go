@@ -0,0 +1,114 @@
+package builderutil
+
+import "sync"
+
+// Builder accumulates Lister options across several code paths and applies them when Build
+// is called, supporting a fluent style and late binding of options. The zero value is an
+// empty Builder ready to use. A Builder is not safe for concurrent use.
+type Builder[T any] struct {
+	opts []Lister[T]
+}
+
+// NewBuilder returns a Builder holding the given options.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] to start with.
+//
+// Returns:
+// - A pointer to the new Builder.
+func NewBuilder[T any](opts ...Lister[T]) *Builder[T] {
+	return new(Builder[T]).Add(opts...)
+}
+
+// Add appends options to the Builder and returns it, so calls can be chained.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] to append.
+//
+// Returns:
+// - The Builder itself.
+func (b *Builder[T]) Add(opts ...Lister[T]) *Builder[T] {
+
+	b.opts = append(b.opts, opts...)
+
+	return b
+}
+
+// Build constructs an instance of T by applying the accumulated options in insertion order,
+// exactly like Build. The Builder keeps its options, so it can be built again.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError for the failing function.
+func (b *Builder[T]) Build() (*T, error) {
+	return Build(b.opts...)
+}
+
+// Reset removes every accumulated option.
+func (b *Builder[T]) Reset() {
+	b.opts = nil
+}
+
+// Snapshot captures the options accumulated so far and returns a closure that restores them,
+// discarding anything added or reset afterwards. The captured set is copied, so it is not
+// affected by later calls on the Builder, and the closure can be called any number of times.
+// This suits scenario-based tests that share a setup and then diverge.
+//
+// Returns:
+// - A function restoring the Builder to the captured options.
+func (b *Builder[T]) Snapshot() func() {
+
+	captured := append([]Lister[T](nil), b.opts...)
+
+	return func() {
+		b.opts = append([]Lister[T](nil), captured...)
+	}
+}
+
+// SyncBuilder is a Builder whose methods are safe for concurrent use, for example when
+// several plugins register options from different goroutines. Build takes a snapshot of the
+// options under the lock and applies it outside of it, so registration is never blocked by
+// slow options. The option functions themselves still run sequentially, on the goroutine
+// calling Build. The zero value is an empty SyncBuilder ready to use.
+type SyncBuilder[T any] struct {
+	mu   sync.Mutex
+	opts []Lister[T]
+}
+
+// Add appends options to the SyncBuilder and returns it, so calls can be chained.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] to append.
+//
+// Returns:
+// - The SyncBuilder itself.
+func (b *SyncBuilder[T]) Add(opts ...Lister[T]) *SyncBuilder[T] {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.opts = append(b.opts, opts...)
+
+	return b
+}
+
+// Build constructs an instance of T from a snapshot of the options added so far.
+// Options added concurrently with Build are not part of the snapshot.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError for the failing function.
+func (b *SyncBuilder[T]) Build() (*T, error) {
+
+	b.mu.Lock()
+	opts := append([]Lister[T](nil), b.opts...)
+	b.mu.Unlock()
+
+	return Build(opts...)
+}
+
+// Reset removes every accumulated option.
+func (b *SyncBuilder[T]) Reset() {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.opts = nil
+}
@@ -0,0 +1,137 @@
+package builderutil_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// builderConfig is a configuration type recording the order in which options ran.
+type builderConfig struct {
+	Steps []string
+}
+
+// appendStep returns a Lister appending name to the recorded steps.
+func appendStep(name string) builderutil.Lister[builderConfig] {
+	return builderutil.Options[builderConfig]{func(c *builderConfig) error {
+		c.Steps = append(c.Steps, name)
+		return nil
+	}}
+}
+
+// TestBuilder_Add tests if chained Add calls accumulate options applied in insertion order.
+func TestBuilder_Add(t *testing.T) {
+	var b builderutil.Builder[builderConfig]
+
+	b.Add(appendStep("a")).Add(appendStep("b"), appendStep("c"))
+	b.Add(appendStep("d"))
+
+	config, err := b.Build()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"a", "b", "c", "d"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+}
+
+// TestBuilder_Reset tests if Reset clears the accumulated options.
+func TestBuilder_Reset(t *testing.T) {
+	b := builderutil.NewBuilder(appendStep("a"))
+	b.Reset()
+	b.Add(appendStep("b"))
+
+	config, err := b.Build()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"b"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+}
+
+// TestBuilder_Snapshot tests if restoring a snapshot reverts the options added after it.
+func TestBuilder_Snapshot(t *testing.T) {
+	b := builderutil.NewBuilder(appendStep("setup"))
+
+	restore := b.Snapshot()
+
+	// Diverge from the shared setup in two different ways
+	b.Add(appendStep("scenario-a"))
+	restore()
+	b.Add(appendStep("scenario-b"))
+
+	config, err := b.Build()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"setup", "scenario-b"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+
+	// The snapshot is not affected by Reset or later additions
+	b.Reset()
+	restore()
+
+	config, err = b.Build()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected = []string{"setup"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+}
+
+// TestSyncBuilder_ConcurrentAdd tests if SyncBuilder accepts options from many goroutines; run it with -race.
+func TestSyncBuilder_ConcurrentAdd(t *testing.T) {
+	type Config struct {
+		Count int
+	}
+
+	increment := builderutil.Options[Config]{func(c *Config) error {
+		c.Count++
+		return nil
+	}}
+
+	var b builderutil.SyncBuilder[Config]
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.Add(increment)
+		}()
+	}
+
+	// Build concurrently with registration to exercise the snapshot
+	if _, err := b.Build(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	wg.Wait()
+
+	config, err := b.Build()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Count != 100 {
+		t.Errorf("Expected config.Count to be 100, got %d", config.Count)
+	}
+
+	b.Reset()
+	if config, _ := b.Build(); config.Count != 0 {
+		t.Errorf("Expected config.Count to be 0 after Reset, got %d", config.Count)
+	}
+}
@@ -0,0 +1,69 @@
+// Package buildertest provides test helpers for code that builds values with builderutil.
+package buildertest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// AssertBuilds builds an instance of T from opts and reports a test failure unless it equals
+// want. A build error fails the test immediately. On mismatch, the failure message lists every
+// differing field as "path: got ..., want ...", descending into nested structs, which is far
+// easier to read than a plain reflect.DeepEqual failure.
+// Parameters:
+// - t: The test or benchmark to report failures to.
+// - want: The expected result of the build.
+// - opts: Variadic arguments of type builderutil.Lister[T] that provide configuration functions.
+func AssertBuilds[T any](t testing.TB, want T, opts ...builderutil.Lister[T]) {
+	t.Helper()
+
+	got, err := builderutil.Build(opts...)
+	if err != nil {
+		t.Fatalf("build failed: %v", err)
+		return
+	}
+
+	if reflect.DeepEqual(*got, want) {
+		return
+	}
+
+	var diffs []string
+	diff(&diffs, reflect.TypeOf(want).Name(), reflect.ValueOf(*got), reflect.ValueOf(want))
+	if len(diffs) == 0 {
+		diffs = append(diffs, "unexported fields differ")
+	}
+
+	t.Errorf("build result differs from expected:\n\t%s", strings.Join(diffs, "\n\t"))
+}
+
+// diff appends a line to diffs for every difference between got and want, which have the
+// same type. Structs are compared field by field; other values are compared as a whole.
+// Unexported values are only reported when their type is comparable.
+func diff(diffs *[]string, path string, got, want reflect.Value) {
+
+	if got.Kind() != reflect.Struct {
+		if !got.CanInterface() {
+			if got.Comparable() && !got.Equal(want) {
+				*diffs = append(*diffs, fmt.Sprintf("%s: unexported values differ", path))
+			}
+			return
+		}
+		if !reflect.DeepEqual(got.Interface(), want.Interface()) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: got %#v, want %#v", path, got.Interface(), want.Interface()))
+		}
+		return
+	}
+
+	for i := 0; i < got.NumField(); i++ {
+		name := got.Type().Field(i).Name
+		if path != "" {
+			name = path + "." + name
+		}
+
+		diff(diffs, name, got.Field(i), want.Field(i))
+	}
+}
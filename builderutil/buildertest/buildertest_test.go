@@ -0,0 +1,105 @@
+package buildertest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+	"github.com/zeroxsolutions/go-utils/builderutil/buildertest"
+)
+
+// Config is a configuration type with a nested struct used to exercise the helper.
+type Config struct {
+	Name   string
+	Server Server
+	Tags   []string
+}
+
+// Server is the nested part of Config.
+type Server struct {
+	Host string
+	Port int
+}
+
+// recorder is a testing.TB that records failures instead of reporting them.
+type recorder struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.fatal = true
+	r.Errorf(format, args...)
+}
+
+// setServer returns an option setting the server address.
+func setServer(host string, port int) func(*Config) error {
+	return func(c *Config) error {
+		c.Server = Server{Host: host, Port: port}
+		return nil
+	}
+}
+
+// TestAssertBuilds_Pass tests if a matching build reports no failure.
+func TestAssertBuilds_Pass(t *testing.T) {
+	r := &recorder{TB: t}
+
+	buildertest.AssertBuilds(r, Config{Server: Server{Host: "localhost", Port: 8080}},
+		builderutil.Options[Config]{setServer("localhost", 8080)})
+
+	if len(r.errors) != 0 {
+		t.Errorf("Expected no failures, got %v", r.errors)
+	}
+
+	// The helper also passes on a real test
+	buildertest.AssertBuilds(t, Config{Server: Server{Host: "localhost", Port: 8080}},
+		builderutil.Options[Config]{setServer("localhost", 8080)})
+}
+
+// TestAssertBuilds_Fail tests if a mismatch reports a readable field-by-field diff.
+func TestAssertBuilds_Fail(t *testing.T) {
+	r := &recorder{TB: t}
+
+	want := Config{Name: "api", Server: Server{Host: "localhost", Port: 8080}}
+	buildertest.AssertBuilds(r, want, builderutil.Options[Config]{setServer("localhost", 80)})
+
+	if len(r.errors) != 1 {
+		t.Fatalf("Expected 1 failure, got %v", r.errors)
+	}
+
+	msg := r.errors[0]
+	for _, line := range []string{
+		`Config.Name: got "", want "api"`,
+		"Config.Server.Port: got 80, want 8080",
+	} {
+		if !strings.Contains(msg, line) {
+			t.Errorf("Expected failure to contain %q, got:\n%s", line, msg)
+		}
+	}
+	if strings.Contains(msg, "Host") || strings.Contains(msg, "Tags") {
+		t.Errorf("Expected equal fields not to be reported, got:\n%s", msg)
+	}
+}
+
+// TestAssertBuilds_BuildError tests if a failing build is reported as fatal.
+func TestAssertBuilds_BuildError(t *testing.T) {
+	r := &recorder{TB: t}
+
+	errFunc := func(*Config) error {
+		return fmt.Errorf("boom")
+	}
+
+	buildertest.AssertBuilds(r, Config{}, builderutil.Options[Config]{errFunc})
+
+	if !r.fatal || len(r.errors) != 1 || !strings.Contains(r.errors[0], "boom") {
+		t.Errorf("Expected a fatal failure mentioning the error, got %v", r.errors)
+	}
+}
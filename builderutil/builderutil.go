@@ -2,11 +2,21 @@
 // configuring instances of generic types using a list of functional options.
 package builderutil
 
-import "reflect"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
 
 // Lister is a generic interface that requires a method to return a list of functions.
 // These functions are used to configure or modify an instance of type T.
 // The functions in the list are expected to take a pointer to T and return an error.
+//
+// Build and its variants skip nil Listers, including typed nil pointers, which requires a
+// reflective check for implementations outside this package. Code that builds many small
+// objects in a hot loop should prefer Options, FromFuncs or Chain, which are recognised
+// without reflection, or pass only non-nil Listers.
 type Lister[T any] interface {
 	// List returns a slice of functions, each of which modifies the instance of T
 	// or returns an error if the modification fails.
@@ -16,7 +26,11 @@ type Lister[T any] interface {
 // Build constructs and configures an instance of type T using the provided Lister options.
 // Each Lister option can return a list of functions that are called in sequence to modify
 // the instance of T. If any function returns an error, the Build function stops and returns
-// that error. If an option is nil or its List method returns nil, it is skipped.
+// that error wrapped in a *BuildError identifying the failing option. If an option is nil or
+// its List method returns an empty list, it is skipped.
+//
+// List is called exactly once per option and its result is iterated in place, so Build
+// allocates nothing beyond the new instance of T for the options themselves.
 // Parameters:
 // - opts: Variadic arguments of type Lister[T] that provide configuration functions.
 //
@@ -24,27 +38,354 @@ type Lister[T any] interface {
 // - A pointer to the newly constructed instance of T.
 // - An error if any configuration function fails.
 func Build[T any](opts ...Lister[T]) (*T, error) {
+	return BuildContext(context.Background(), opts...)
+}
+
+// BuildContext is like Build but checks ctx before invoking each configuration function.
+// If the context is cancelled or its deadline is exceeded, the build aborts early and
+// returns the context error. This lets callers bound the total time spent building when
+// options perform slow work such as dialing a remote service.
+//
+// Option functions do not receive ctx themselves. Options that need request-scoped values,
+// such as a tenant ID carried by the context, opt in by being adapted with WithContext using
+// the same ctx that is passed to BuildContext:
+//
+//	cfg, err := builderutil.BuildContext(ctx, builderutil.Options[Config]{
+//		builderutil.WithContext(ctx, func(ctx context.Context, c *Config) error {
+//			c.Tenant = TenantFromContext(ctx)
+//			return nil
+//		}),
+//	})
+//
+// The adapted function then sees the context unchanged, values and cancellation included.
+// Parameters:
+// - ctx: The context that controls cancellation of the build.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - An error if the context is done or any configuration function fails.
+func BuildContext[T any](ctx context.Context, opts ...Lister[T]) (*T, error) {
 
 	t := new(T)
 
-	for _, opt := range opts {
-		if opt == nil || reflect.ValueOf(opt).IsNil() {
+	if err := apply(ctx, t, opts, nil); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// BuildInto applies the configuration functions provided by the Lister options to an
+// existing instance of T in place, instead of starting from a zero value. Fields that
+// are not touched by any option keep their current values. Options are processed with
+// the same rules as Build: nil options, nil lists and nil functions are skipped, and
+// the first error stops the process. Functions applied before the error are not undone.
+// Parameters:
+// - target: A pointer to the instance of T to configure. It must not be nil.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - ErrNilTarget if target is nil, or a *FrozenError if it was returned by BuildFrozen.
+// - An error if any configuration function fails.
+func BuildInto[T any](target *T, opts ...Lister[T]) error {
+
+	if target == nil {
+		return ErrNilTarget
+	}
+
+	if err := checkFrozen(target); err != nil {
+		return err
+	}
+
+	return apply(context.Background(), target, opts, nil)
+}
+
+// BuildAtomic is like BuildInto but all-or-nothing: the options are applied to a deep copy of
+// target, which is committed back only if every function succeeds. On error target is left
+// completely unchanged, even by functions that mutated slices or maps in place, so in-place
+// reconfiguration never leaves it partially configured. On success the pointer, slice and map
+// fields of target refer to the copies made for the build rather than to their previous data;
+// channels, functions and unexported fields are shared with the previous state.
+// Parameters:
+// - target: A pointer to the instance of T to reconfigure. It must not be nil.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - ErrNilTarget if target is nil, or a *FrozenError if it was returned by BuildFrozen.
+// - An error if any configuration function fails, in which case target is untouched.
+func BuildAtomic[T any](target *T, opts ...Lister[T]) error {
+
+	if target == nil {
+		return ErrNilTarget
+	}
+
+	if err := checkFrozen(target); err != nil {
+		return err
+	}
+
+	staged := deepClone(target)
+
+	if err := apply(context.Background(), staged, opts, nil); err != nil {
+		return err
+	}
+
+	cloneInto(target, staged)
+
+	return nil
+}
+
+// Apply is the lowest-level primitive of this package: it applies the configuration
+// functions directly to an existing instance of T, without any Lister indirection.
+// Nil functions are skipped and the first error stops the process; functions applied
+// before the error are not undone, so their changes remain visible on t. The frozen check
+// costs one atomic load while no BuildFrozen instance is alive, and a registry lookup otherwise.
+// Parameters:
+// - t: A pointer to the instance of T to configure. It must not be nil.
+// - fns: Variadic configuration functions to apply in order.
+//
+// Returns:
+// - ErrNilTarget if t is nil, or a *FrozenError if it was returned by BuildFrozen.
+// - The error returned by the first failing function, unwrapped.
+func Apply[T any](t *T, fns ...func(*T) error) error {
+
+	if t == nil {
+		return ErrNilTarget
+	}
+
+	if err := checkFrozen(t); err != nil {
+		return err
+	}
+
+	for _, fn := range fns {
+
+		if fn == nil {
 			continue
 		}
 
-		for _, setArgs := range opt.List() {
+		if err := fn(t); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// isNil reports whether v is a nil interface or an interface holding a nil value,
+// such as a typed nil pointer. Values of kinds that cannot be nil are never nil.
+func isNil(v any) bool {
+
+	if v == nil {
+		return true
+	}
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+// isNilLister reports whether l should be skipped as nil. The interface comparison and the
+// Lister types of this package are checked without reflection; reflection is only used to
+// detect typed nil values of other implementations.
+func isNilLister[T any](l Lister[T]) bool {
+
+	switch l := l.(type) {
+	case nil:
+		return true
+	case Options[T]:
+		return l == nil
+	case chain[T]:
+		return l == nil
+	case *lazy[T]:
+		return l == nil
+	case wrapped[T], group[T], delta[T]:
+		return false
+	default:
+		return isNil(l)
+	}
+}
+
+// apply runs the configuration functions of opts on target in order, skipping nil
+// options and nil functions, and stops at the first context or function error.
+// If call is not nil, it is used to invoke each function together with its lister and
+// function indices, which lets build variants observe or alter individual invocations.
+func apply[T any](ctx context.Context, target *T, opts []Lister[T], call func(i, j int, fn func(*T) error) error) error {
+
+	for i, opt := range opts {
+		if isNilLister(opt) {
+			continue
+		}
+
+		fns := opt.List()
+		if len(fns) == 0 {
+			continue
+		}
+
+		for j, setArgs := range fns {
 
 			if setArgs == nil {
 				continue
 			}
 
-			if err := setArgs(t); err != nil {
-				return nil, err
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			var err error
+			if call != nil {
+				err = call(i, j, setArgs)
+			} else {
+				err = setArgs(target)
+			}
+
+			if err != nil {
+				return &BuildError{ListerIndex: i, FuncIndex: j, Err: err}
 			}
 
 		}
 
 	}
 
+	return nil
+}
+
+// BuildFrom constructs an instance of type T starting from a copy of template and then
+// applies the provided Lister options to it, leaving template itself untouched.
+// The copy is shallow: value fields are isolated between builds, but pointer, slice and
+// map fields still share their underlying data with the template, so options that mutate
+// such data in place affect every build made from the same template.
+// Parameters:
+// - template: The prototype value copied into the new instance.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - An error if any configuration function fails.
+func BuildFrom[T any](template T, opts ...Lister[T]) (*T, error) {
+
+	t := new(T)
+	*t = template
+
+	if err := apply(context.Background(), t, opts, nil); err != nil {
+		return nil, err
+	}
+
 	return t, nil
 }
+
+// BuildWith is like Build but obtains the initial instance from factory instead of new(T),
+// for types that need non-zero initialization before options run, such as allocated maps or
+// a constructor-provided default state. The instance returned by factory is configured in
+// place and returned. A nil factory falls back to new(T).
+// Parameters:
+// - factory: Creates the instance to configure; it must not return nil.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - The pointer returned by factory, configured.
+// - ErrNilTarget if factory returns nil, or an error if any configuration function fails.
+func BuildWith[T any](factory func() *T, opts ...Lister[T]) (*T, error) {
+
+	if factory == nil {
+		return Build(opts...)
+	}
+
+	t := factory()
+	if err := BuildInto(t, opts...); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// BuildSlice constructs n independently-built instances of type T using the same Lister
+// options. Each element gets its own freshly allocated *T, so options that set per-instance
+// state work as expected. The build stops at the first failing element.
+// Parameters:
+// - n: The number of instances to build. It must not be negative.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A non-nil slice of n pointers to the constructed instances.
+// - ErrNegativeCount if n is negative, or an *ElementError wrapping the first failure.
+func BuildSlice[T any](n int, opts ...Lister[T]) ([]*T, error) {
+
+	if n < 0 {
+		return nil, ErrNegativeCount
+	}
+
+	ts := make([]*T, n)
+
+	for i := range ts {
+		t, err := Build(opts...)
+		if err != nil {
+			return nil, &ElementError{Index: i, Err: err}
+		}
+
+		ts[i] = t
+	}
+
+	return ts, nil
+}
+
+// BuildAll is like Build but runs every configuration function regardless of failures,
+// instead of stopping at the first one. All errors are aggregated with errors.Join, so
+// errors.Is and errors.As work against each original error. This suits validation-style
+// options where every misconfiguration should be reported at once.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the constructed instance of T, never nil so partial state can be inspected.
+// - The joined *BuildError values of all failing functions, or nil if none failed.
+func BuildAll[T any](opts ...Lister[T]) (*T, error) {
+
+	t := new(T)
+
+	var errs []error
+
+	for i, opt := range opts {
+		if isNilLister(opt) {
+			continue
+		}
+
+		for j, setArgs := range opt.List() {
+
+			if setArgs == nil {
+				continue
+			}
+
+			if err := setArgs(t); err != nil {
+				errs = append(errs, &BuildError{ListerIndex: i, FuncIndex: j, Err: err})
+			}
+
+		}
+
+	}
+
+	return t, errors.Join(errs...)
+}
+
+// MustBuild is like Build but panics if any configuration function fails.
+// It is intended for package-level variable initialization and test setup, where
+// handling the error is impractical, similar to template.Must in the standard library.
+// The panic value is an error wrapping the original one, so callers that recover
+// can inspect it with errors.Is or errors.As.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+func MustBuild[T any](opts ...Lister[T]) *T {
+
+	t, err := Build(opts...)
+	if err != nil {
+		panic(fmt.Errorf("builderutil: MustBuild failed: %w", err))
+	}
+
+	return t
+}
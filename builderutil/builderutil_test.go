@@ -1,7 +1,10 @@
 package builderutil_test
 
 import (
+	"context"
 	"errors"
+	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/zeroxsolutions/go-utils/builderutil"
@@ -170,3 +173,737 @@ func TestBuild_EmptyOptions(t *testing.T) {
 		t.Errorf("Expected config.Value to be 0, got %d", config.Value)
 	}
 }
+
+// TestMustBuild_Success tests if MustBuild returns the configured instance when no function fails.
+func TestMustBuild_Success(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	setValue := func(c *Config) error {
+		c.Value = 42
+		return nil
+	}
+
+	config := builderutil.MustBuild[Config](&MockLister[Config]{Funcs: []func(*Config) error{setValue}})
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// configError is a custom error type used to verify errors.As on recovered panic values.
+type configError struct {
+	Field string
+}
+
+func (e *configError) Error() string {
+	return "invalid field " + e.Field
+}
+
+// TestMustBuild_Panic tests if MustBuild panics with a value that unwraps to the underlying error.
+func TestMustBuild_Panic(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFunc := func(*Config) error {
+		return &configError{Field: "Value"}
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("Expected MustBuild to panic, but it did not")
+		}
+
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("Expected panic value to be an error, got %T", r)
+		}
+
+		// Verify that the recovered value unwraps to the original error
+		var target *configError
+		if !errors.As(err, &target) {
+			t.Fatalf("Expected recovered error to unwrap to *configError, got %v", err)
+		}
+		if target.Field != "Value" {
+			t.Errorf("Expected target.Field to be Value, got %s", target.Field)
+		}
+	}()
+
+	builderutil.MustBuild[Config](&MockLister[Config]{Funcs: []func(*Config) error{errFunc}})
+}
+
+// TestBuildInto_PreservesFields tests if BuildInto keeps existing field values unless an option overwrites them.
+func TestBuildInto_PreservesFields(t *testing.T) {
+	type Config struct {
+		Name  string
+		Value int
+	}
+
+	setValue := func(value int) func(*Config) error {
+		return func(c *Config) error {
+			c.Value = value
+			return nil
+		}
+	}
+
+	// Start from a partially-initialized instance
+	config := &Config{Name: "loaded", Value: 1}
+
+	err := builderutil.BuildInto[Config](config, &MockLister[Config]{Funcs: []func(*Config) error{setValue(42)}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Verify that the untouched field was preserved and the option was applied
+	if config.Name != "loaded" {
+		t.Errorf("Expected config.Name to be loaded, got %s", config.Name)
+	}
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// TestBuildInto_NilTarget tests if BuildInto rejects a nil target with ErrNilTarget.
+func TestBuildInto_NilTarget(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	err := builderutil.BuildInto[Config](nil)
+	if !errors.Is(err, builderutil.ErrNilTarget) {
+		t.Fatalf("Expected ErrNilTarget, got %v", err)
+	}
+}
+
+// TestBuildInto_ErrorInFunction tests if BuildInto stops at the first error and keeps earlier mutations.
+func TestBuildInto_ErrorInFunction(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	setValue := func(value int) func(*Config) error {
+		return func(c *Config) error {
+			c.Value = value
+			return nil
+		}
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	config := &Config{}
+
+	mockLister := &MockLister[Config]{Funcs: []func(*Config) error{setValue(1), errFunc, setValue(2)}}
+
+	err := builderutil.BuildInto[Config](config, mockLister)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	// Verify that the function before the error was applied and the one after was not
+	if config.Value != 1 {
+		t.Errorf("Expected config.Value to be 1, got %d", config.Value)
+	}
+}
+
+// TestBuildAtomic tests if a failing option leaves the original target completely unchanged.
+func TestBuildAtomic(t *testing.T) {
+	type Config struct {
+		Name   string
+		Tags   []string
+		Labels map[string]string
+	}
+
+	errFailed := errors.New("error in function")
+
+	target := &Config{Name: "api", Tags: []string{"a"}, Labels: map[string]string{"env": "prod"}}
+
+	mutate := func(c *Config) error {
+		c.Name = "changed"
+		c.Tags[0] = "changed"
+		c.Labels["env"] = "changed"
+		return nil
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	err := builderutil.BuildAtomic[Config](target, &MockLister[Config]{Funcs: []func(*Config) error{mutate, errFunc}})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	expected := Config{Name: "api", Tags: []string{"a"}, Labels: map[string]string{"env": "prod"}}
+	if !reflect.DeepEqual(*target, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, *target)
+	}
+
+	if err := builderutil.BuildAtomic[Config](target, &MockLister[Config]{Funcs: []func(*Config) error{mutate}}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if target.Name != "changed" || target.Tags[0] != "changed" || target.Labels["env"] != "changed" {
+		t.Errorf("Expected the changes to be committed, got %+v", *target)
+	}
+
+	if err := builderutil.BuildAtomic[Config](nil); !errors.Is(err, builderutil.ErrNilTarget) {
+		t.Errorf("Expected ErrNilTarget, got %v", err)
+	}
+}
+
+// TestBuildContext_Cancelled tests if BuildContext returns the context error without applying options.
+func TestBuildContext_Cancelled(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	called := false
+	setValue := func(c *Config) error {
+		called = true
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	config, err := builderutil.BuildContext[Config](ctx, &MockLister[Config]{Funcs: []func(*Config) error{setValue}})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	if config != nil {
+		t.Errorf("Expected config to be nil, got %v", config)
+	}
+	if called {
+		t.Error("Expected option not to be called on a cancelled context")
+	}
+}
+
+// TestBuildContext_CancelledPartway tests if BuildContext stops once the context is cancelled by an option.
+func TestBuildContext_CancelledPartway(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	count := func(c *Config) error {
+		calls++
+		return nil
+	}
+	cancelFunc := func(c *Config) error {
+		calls++
+		cancel()
+		return nil
+	}
+
+	// The second option cancels the context, so the third one must never run
+	mockLister1 := &MockLister[Config]{Funcs: []func(*Config) error{count, cancelFunc}}
+	mockLister2 := &MockLister[Config]{Funcs: []func(*Config) error{count}}
+
+	_, err := builderutil.BuildContext[Config](ctx, mockLister1, mockLister2)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 calls before cancellation, got %d", calls)
+	}
+}
+
+// TestBuildAll_JoinsErrors tests if BuildAll runs every option and joins all errors.
+func TestBuildAll_JoinsErrors(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFirst := errors.New("first error")
+	errSecond := errors.New("second error")
+
+	setValue := func(value int) func(*Config) error {
+		return func(c *Config) error {
+			c.Value = value
+			return nil
+		}
+	}
+	failWith := func(err error) func(*Config) error {
+		return func(*Config) error {
+			return err
+		}
+	}
+
+	// Create listers where failures are interleaved with valid functions
+	mockLister1 := &MockLister[Config]{Funcs: []func(*Config) error{failWith(errFirst), setValue(1)}}
+	mockLister2 := &MockLister[Config]{Funcs: []func(*Config) error{failWith(errSecond), setValue(42)}}
+
+	config, err := builderutil.BuildAll[Config](mockLister1, mockLister2)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	// Verify that each original error can be matched
+	if !errors.Is(err, errFirst) {
+		t.Errorf("Expected error to match %v", errFirst)
+	}
+	if !errors.Is(err, errSecond) {
+		t.Errorf("Expected error to match %v", errSecond)
+	}
+
+	// Verify that the instance is returned with every valid function applied
+	if config == nil {
+		t.Fatal("Expected config to be non-nil")
+	}
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// TestBuildAll_NoErrors tests if BuildAll returns a nil error when every option succeeds.
+func TestBuildAll_NoErrors(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	setValue := func(c *Config) error {
+		c.Value = 42
+		return nil
+	}
+
+	config, err := builderutil.BuildAll[Config](nil, &MockLister[Config]{Funcs: []func(*Config) error{nil, setValue}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// TestBuildFrom_IsolatesTemplate tests if concurrent BuildFrom calls on the same template produce independent results.
+func TestBuildFrom_IsolatesTemplate(t *testing.T) {
+	type Config struct {
+		Name  string
+		Value int
+	}
+
+	setValue := func(value int) func(*Config) error {
+		return func(c *Config) error {
+			c.Value += value
+			return nil
+		}
+	}
+
+	template := Config{Name: "template", Value: 1}
+
+	// Build many instances concurrently from the same template
+	results := make([]*Config, 10)
+	errs := make([]error, 10)
+
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = builderutil.BuildFrom[Config](template, &MockLister[Config]{Funcs: []func(*Config) error{setValue(i)}})
+		}(i)
+	}
+	wg.Wait()
+
+	// Verify that each build started from the template and only received its own option
+	for i, config := range results {
+		if errs[i] != nil {
+			t.Fatalf("Expected no error, got %v", errs[i])
+		}
+		if config.Name != "template" {
+			t.Errorf("Expected config.Name to be template, got %s", config.Name)
+		}
+		if config.Value != 1+i {
+			t.Errorf("Expected config.Value to be %d, got %d", 1+i, config.Value)
+		}
+	}
+
+	// Verify that the template was not mutated
+	if template.Value != 1 {
+		t.Errorf("Expected template.Value to be 1, got %d", template.Value)
+	}
+}
+
+// TestBuildWith_Factory tests if options see the fields pre-initialized by the factory.
+func TestBuildWith_Factory(t *testing.T) {
+	type Config struct {
+		Labels map[string]string
+	}
+
+	factory := func() *Config {
+		return &Config{Labels: map[string]string{"env": "prod"}}
+	}
+
+	var seen string
+	setLabel := func(c *Config) error {
+		seen = c.Labels["env"]
+		c.Labels["team"] = "core"
+		return nil
+	}
+
+	config, err := builderutil.BuildWith[Config](factory, &MockLister[Config]{Funcs: []func(*Config) error{setLabel}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if seen != "prod" {
+		t.Errorf("Expected the option to see the factory label, got '%s'", seen)
+	}
+	if len(config.Labels) != 2 {
+		t.Errorf("Expected 2 labels, got %v", config.Labels)
+	}
+}
+
+// TestBuildWith_NilFactory tests if a nil factory falls back to a zero instance and a nil result is rejected.
+func TestBuildWith_NilFactory(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	config, err := builderutil.BuildWith[Config](nil, &MockLister[Config]{Funcs: []func(*Config) error{func(c *Config) error {
+		c.Value = 42
+		return nil
+	}}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+
+	_, err = builderutil.BuildWith[Config](func() *Config { return nil })
+	if !errors.Is(err, builderutil.ErrNilTarget) {
+		t.Errorf("Expected ErrNilTarget, got %v", err)
+	}
+}
+
+// TestBuildSlice_Success tests if BuildSlice produces independent instances.
+func TestBuildSlice_Success(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	setValue := func(c *Config) error {
+		c.Value++
+		return nil
+	}
+
+	configs, err := builderutil.BuildSlice[Config](3, &MockLister[Config]{Funcs: []func(*Config) error{setValue}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(configs) != 3 {
+		t.Fatalf("Expected 3 configs, got %d", len(configs))
+	}
+
+	// Verify that every element was built from its own zero value
+	for i, config := range configs {
+		if config.Value != 1 {
+			t.Errorf("Expected configs[%d].Value to be 1, got %d", i, config.Value)
+		}
+	}
+	if configs[0] == configs[1] {
+		t.Error("Expected distinct pointers for each element")
+	}
+}
+
+// TestBuildSlice_Zero tests if BuildSlice returns an empty non-nil slice for n=0.
+func TestBuildSlice_Zero(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	configs, err := builderutil.BuildSlice[Config](0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if configs == nil || len(configs) != 0 {
+		t.Errorf("Expected an empty non-nil slice, got %v", configs)
+	}
+}
+
+// TestBuildSlice_Negative tests if BuildSlice rejects a negative count.
+func TestBuildSlice_Negative(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	_, err := builderutil.BuildSlice[Config](-1)
+	if !errors.Is(err, builderutil.ErrNegativeCount) {
+		t.Fatalf("Expected ErrNegativeCount, got %v", err)
+	}
+}
+
+// TestBuildSlice_ErrorInElement tests if BuildSlice reports the index of the failed element.
+func TestBuildSlice_ErrorInElement(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	// Fail on the third build, which is element 2
+	calls := 0
+	failThird := func(c *Config) error {
+		calls++
+		if calls == 3 {
+			return errFailed
+		}
+		return nil
+	}
+
+	configs, err := builderutil.BuildSlice[Config](5, &MockLister[Config]{Funcs: []func(*Config) error{failThird}})
+
+	var elemErr *builderutil.ElementError
+	if !errors.As(err, &elemErr) {
+		t.Fatalf("Expected *ElementError, got %v", err)
+	}
+	if elemErr.Index != 2 {
+		t.Errorf("Expected Index to be 2, got %d", elemErr.Index)
+	}
+	if !errors.Is(err, errFailed) {
+		t.Errorf("Expected error to match %v", errFailed)
+	}
+	if configs != nil {
+		t.Errorf("Expected configs to be nil, got %v", configs)
+	}
+}
+
+// valueLister is a non-pointer Lister implementation used to verify Build does not panic on value types.
+type valueLister struct {
+	value int
+}
+
+// List returns a single function setting the value held by the lister.
+func (l valueLister) List() []func(*struct{ Value int }) error {
+	return []func(*struct{ Value int }) error{func(c *struct{ Value int }) error {
+		c.Value = l.value
+		return nil
+	}}
+}
+
+// TestBuild_ValueLister tests if Build accepts Lister implementations that are not pointers.
+func TestBuild_ValueLister(t *testing.T) {
+	config, err := builderutil.Build[struct{ Value int }](valueLister{value: 42})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// countingLister is a Lister that records how many times its List method is called.
+type countingLister[T any] struct {
+	Funcs []func(*T) error
+	Calls int
+}
+
+// List returns the held functions and increments the call counter.
+func (c *countingLister[T]) List() []func(*T) error {
+	c.Calls++
+	return c.Funcs
+}
+
+// TestBuild_ListCalledOnce tests if Build invokes List exactly once per lister, including empty ones.
+func TestBuild_ListCalledOnce(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	setValue := func(c *Config) error {
+		c.Value++
+		return nil
+	}
+
+	full := &countingLister[Config]{Funcs: []func(*Config) error{setValue, nil, setValue}}
+	empty := &countingLister[Config]{}
+
+	config, err := builderutil.Build[Config](full, empty)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 2 {
+		t.Errorf("Expected config.Value to be 2, got %d", config.Value)
+	}
+	if full.Calls != 1 || empty.Calls != 1 {
+		t.Errorf("Expected List to be called once per lister, got %d and %d", full.Calls, empty.Calls)
+	}
+}
+
+// BenchmarkBuild_LargeLister measures the allocation profile of a lister holding 1000 functions.
+func BenchmarkBuild_LargeLister(b *testing.B) {
+	type Config struct {
+		Value int
+	}
+
+	fns := make([]func(*Config) error, 1000)
+	for i := range fns {
+		fns[i] = func(c *Config) error {
+			c.Value++
+			return nil
+		}
+	}
+	lister := &MockLister[Config]{Funcs: fns}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := builderutil.Build[Config](lister); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchmarkSmallBuild builds a small Config repeatedly with a pre-boxed Lister so that only
+// the cost of Build itself, including its nil check, is measured.
+func benchmarkSmallBuild(b *testing.B, lister builderutil.Lister[struct{ Value int }]) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := builderutil.Build(lister); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkBuild_ReflectiveNilCheck measures Build with a custom pointer Lister, whose nil check uses reflection.
+func BenchmarkBuild_ReflectiveNilCheck(b *testing.B) {
+	setValue := func(c *struct{ Value int }) error {
+		c.Value++
+		return nil
+	}
+
+	benchmarkSmallBuild(b, &MockLister[struct{ Value int }]{Funcs: []func(*struct{ Value int }) error{setValue}})
+}
+
+// BenchmarkBuild_FastNilCheck measures Build with an Options Lister, whose nil check avoids reflection.
+func BenchmarkBuild_FastNilCheck(b *testing.B) {
+	setValue := func(c *struct{ Value int }) error {
+		c.Value++
+		return nil
+	}
+
+	benchmarkSmallBuild(b, builderutil.Options[struct{ Value int }]{setValue})
+}
+
+// TestApply tests if Apply mutates the instance in place and skips nil functions.
+func TestApply(t *testing.T) {
+	type Config struct {
+		Name  string
+		Value int
+	}
+
+	setValue := func(c *Config) error {
+		c.Value = 42
+		return nil
+	}
+
+	config := &Config{Name: "existing"}
+
+	if err := builderutil.Apply(config, nil, setValue); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Name != "existing" || config.Value != 42 {
+		t.Errorf("Expected {existing 42}, got %+v", *config)
+	}
+}
+
+// TestApply_Error tests if Apply returns the first error and keeps the state applied before it.
+func TestApply_Error(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	setValue := func(value int) func(*Config) error {
+		return func(c *Config) error {
+			c.Value = value
+			return nil
+		}
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	config := &Config{}
+
+	err := builderutil.Apply(config, setValue(1), errFunc, setValue(2))
+	if err != errFailed {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if config.Value != 1 {
+		t.Errorf("Expected config.Value to be 1, got %d", config.Value)
+	}
+}
+
+// TestApply_NilTarget tests if Apply rejects a nil target.
+func TestApply_NilTarget(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	if err := builderutil.Apply[Config](nil); !errors.Is(err, builderutil.ErrNilTarget) {
+		t.Fatalf("Expected ErrNilTarget, got %v", err)
+	}
+}
+
+// tenantKey is the context key under which the tests store a tenant ID.
+type tenantKey struct{}
+
+// TenantFromContext returns the tenant ID stored in ctx, or an empty string.
+func TenantFromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+	return tenant
+}
+
+// TestBuildContext_ContextValues tests if a request-scoped value flows unchanged into options adapted with WithContext.
+func TestBuildContext_ContextValues(t *testing.T) {
+	type Config struct {
+		Tenant string
+		Quota  int
+	}
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+
+	var seen context.Context
+	setTenant := func(ctx context.Context, c *Config) error {
+		seen = ctx
+		c.Tenant = TenantFromContext(ctx)
+		if c.Tenant == "acme" {
+			c.Quota = 100
+		}
+		return nil
+	}
+
+	config, err := builderutil.BuildContext[Config](ctx, builderutil.Options[Config]{builderutil.WithContext(ctx, setTenant)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if seen != ctx {
+		t.Error("Expected the option to receive the build context unchanged")
+	}
+	if config.Tenant != "acme" || config.Quota != 100 {
+		t.Errorf("Expected {acme 100}, got %+v", *config)
+	}
+}
@@ -0,0 +1,123 @@
+package builderutil
+
+import "reflect"
+
+// deepClone returns a pointer to a deep copy of *t. Pointers, slices, maps, arrays, interfaces
+// and exported struct fields are copied recursively, preserving nil values. A pointer, map or
+// slice reachable several times is copied once, so cyclic structures are supported, including
+// cycles through t itself, which point to the clone. Channels, functions and unexported struct
+// fields cannot be copied through reflection and are shared with the original.
+func deepClone[T any](t *T) *T {
+
+	c := new(T)
+	cloneInto(c, t)
+
+	return c
+}
+
+// cloneInto stores a deep copy of *src into *dst like deepClone, except that pointers to src
+// itself are redirected to dst. This commits a staging copy back to its original target
+// without leaving cycles pointing at the staging copy. dst may be src, which makes the data
+// reachable from it unshared in place.
+func cloneInto[T any](dst, src *T) {
+
+	seen := map[pointerKey]reflect.Value{
+		{typ: reflect.TypeOf(src), addr: reflect.ValueOf(src).Pointer()}: reflect.ValueOf(dst),
+	}
+
+	deepCopy(reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem(), seen)
+}
+
+// pointerKey identifies a pointer, map or slice by its type, address and, for slices and maps,
+// length, since a slice of a different length over the same array is a different value.
+type pointerKey struct {
+	typ  reflect.Type
+	addr uintptr
+	len  int
+}
+
+// deepCopy stores a deep copy of src into the settable value dst. Copied pointers, maps and
+// slices are recorded in seen before their contents are copied, so that a value reachable
+// several times, or from within itself, is copied once.
+func deepCopy(dst, src reflect.Value, seen map[pointerKey]reflect.Value) {
+
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(src.Type()))
+			return
+		}
+
+		key := pointerKey{typ: src.Type(), addr: src.Pointer()}
+		if p, ok := seen[key]; ok {
+			dst.Set(p)
+			return
+		}
+
+		p := reflect.New(src.Type().Elem())
+		seen[key] = p
+		deepCopy(p.Elem(), src.Elem(), seen)
+		dst.Set(p)
+	case reflect.Slice:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(src.Type()))
+			return
+		}
+
+		key := pointerKey{typ: src.Type(), addr: src.Pointer(), len: src.Len()}
+		if s, ok := seen[key]; ok {
+			dst.Set(s)
+			return
+		}
+
+		s := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		seen[key] = s
+		for i := 0; i < src.Len(); i++ {
+			deepCopy(s.Index(i), src.Index(i), seen)
+		}
+		dst.Set(s)
+	case reflect.Map:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(src.Type()))
+			return
+		}
+
+		key := pointerKey{typ: src.Type(), addr: src.Pointer(), len: src.Len()}
+		if m, ok := seen[key]; ok {
+			dst.Set(m)
+			return
+		}
+
+		m := reflect.MakeMapWithSize(src.Type(), src.Len())
+		seen[key] = m
+		for iter := src.MapRange(); iter.Next(); {
+			v := reflect.New(src.Type().Elem()).Elem()
+			deepCopy(v, iter.Value(), seen)
+			m.SetMapIndex(iter.Key(), v)
+		}
+		dst.Set(m)
+	case reflect.Interface:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(src.Type()))
+			return
+		}
+
+		v := reflect.New(src.Elem().Type()).Elem()
+		deepCopy(v, src.Elem(), seen)
+		dst.Set(v)
+	case reflect.Struct:
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if src.Type().Field(i).IsExported() {
+				deepCopy(dst.Field(i), src.Field(i), seen)
+			}
+		}
+	case reflect.Array:
+		dst.Set(src)
+		for i := 0; i < src.Len(); i++ {
+			deepCopy(dst.Index(i), src.Index(i), seen)
+		}
+	default:
+		dst.Set(src)
+	}
+}
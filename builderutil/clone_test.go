@@ -0,0 +1,97 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// cloneNode is a self-referencing type used by the deep copy tests.
+type cloneNode struct {
+	Name  string
+	Self  *cloneNode
+	Map   map[string]any
+	Slice []any
+}
+
+// TestBuildAtomic_PointerCycle tests if a pointer to the target itself still points to it after the build.
+func TestBuildAtomic_PointerCycle(t *testing.T) {
+	n := &cloneNode{Name: "a"}
+	n.Self = n
+
+	setName := func(c *cloneNode) error {
+		c.Name = "b"
+		return nil
+	}
+
+	if err := builderutil.BuildAtomic(n, builderutil.Options[cloneNode]{setName}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if n.Self != n || n.Self.Name != "b" {
+		t.Errorf("Expected n.Self to be n with Name 'b', got %p (%s) for %p", n.Self, n.Self.Name, n)
+	}
+}
+
+// TestBuildAtomic_MapCycle tests if a map containing itself is copied without unbounded recursion.
+func TestBuildAtomic_MapCycle(t *testing.T) {
+	m := map[string]any{"name": "a"}
+	m["self"] = m
+
+	n := &cloneNode{Map: m}
+
+	errFailed := errors.New("error in function")
+	setName := func(c *cloneNode) error {
+		c.Map["name"] = "b"
+		return errFailed
+	}
+
+	if err := builderutil.BuildAtomic(n, builderutil.Options[cloneNode]{setName}); !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if m["name"] != "a" {
+		t.Errorf("Expected the map to be untouched, got %v", m["name"])
+	}
+
+	setName = func(c *cloneNode) error {
+		c.Map["name"] = "b"
+		return nil
+	}
+
+	if err := builderutil.BuildAtomic(n, builderutil.Options[cloneNode]{setName}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	self, ok := n.Map["self"].(map[string]any)
+	if !ok || self["name"] != "b" {
+		t.Errorf("Expected the copied map to contain itself, got %v", n.Map["self"])
+	}
+}
+
+// TestBuildAtomic_SliceCycle tests if a slice containing itself is copied without unbounded recursion.
+func TestBuildAtomic_SliceCycle(t *testing.T) {
+	s := []any{"a", nil}
+	s[1] = s
+
+	n := &cloneNode{Slice: s}
+
+	setFirst := func(c *cloneNode) error {
+		c.Slice[0] = "b"
+		return nil
+	}
+
+	if err := builderutil.BuildAtomic(n, builderutil.Options[cloneNode]{setFirst}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if s[0] != "a" {
+		t.Errorf("Expected the original slice to be untouched, got %v", s[0])
+	}
+
+	self, ok := n.Slice[1].([]any)
+	if !ok || &self[0] != &n.Slice[0] {
+		t.Errorf("Expected the copied slice to contain itself")
+	}
+}
@@ -0,0 +1,44 @@
+package builderutil
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// FromCommand returns a configuration function that runs an external command and passes its
+// standard output to apply, which integrates helper processes such as secret providers, for
+// example FromCommand(setToken, "vault", "read", "-field=token", "secret/api").
+//
+// The command is started directly with os/exec, never through a shell: name is resolved with
+// exec.LookPath and args are passed verbatim as separate arguments, so they are not subject
+// to interpolation, globbing or word splitting, and untrusted values cannot inject further
+// commands. The command inherits the environment and working directory of the process. Its
+// output is passed to apply unmodified, including any trailing newline.
+//
+// The command runs each time the option is applied. A non-zero exit status fails the option
+// without calling apply; the underlying *exec.ExitError, available through errors.As, holds
+// the command's standard error, which is deliberately left out of the error message since it
+// may contain sensitive data.
+// Parameters:
+// - apply: Stores the command output into the instance of T.
+// - name: The program to run.
+// - args: Variadic arguments passed to the program.
+//
+// Returns:
+// - A configuration function returning an error if the command cannot start or fails.
+func FromCommand[T any](apply func(*T, []byte), name string, args ...string) func(*T) error {
+
+	args = append([]string(nil), args...)
+
+	return func(t *T) error {
+
+		out, err := exec.Command(name, args...).Output()
+		if err != nil {
+			return fmt.Errorf("builderutil: command %q failed: %w", name, err)
+		}
+
+		apply(t, out)
+
+		return nil
+	}
+}
@@ -0,0 +1,65 @@
+package builderutil_test
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// commandConfig is a configuration type populated from command output.
+type commandConfig struct {
+	Token string
+}
+
+// setToken stores the command output as the token.
+func setToken(c *commandConfig, out []byte) {
+	c.Token = string(out)
+}
+
+// TestFromCommand tests if the output of a command is applied without shell interpolation.
+func TestFromCommand(t *testing.T) {
+	if _, err := exec.LookPath("echo"); err != nil {
+		t.Skip("echo is not available")
+	}
+
+	config, err := builderutil.Build[commandConfig](builderutil.Options[commandConfig]{
+		builderutil.FromCommand(setToken, "echo", "-n", "$HOME;", "secret"),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Token != "$HOME; secret" {
+		t.Errorf("Expected config.Token to be '$HOME; secret', got '%s'", config.Token)
+	}
+}
+
+// TestFromCommand_Failure tests if a failing or missing command returns an error without applying output.
+func TestFromCommand_Failure(t *testing.T) {
+	if _, err := exec.LookPath("false"); err != nil {
+		t.Skip("false is not available")
+	}
+
+	var applied bool
+	apply := func(*commandConfig, []byte) {
+		applied = true
+	}
+
+	_, err := builderutil.Build[commandConfig](builderutil.Options[commandConfig]{builderutil.FromCommand(apply, "false")})
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() == 0 {
+		t.Fatalf("Expected *exec.ExitError with a non-zero code, got %v", err)
+	}
+
+	_, err = builderutil.Build[commandConfig](builderutil.Options[commandConfig]{builderutil.FromCommand(apply, "builderutil-no-such-command")})
+	if !errors.Is(err, exec.ErrNotFound) {
+		t.Fatalf("Expected exec.ErrNotFound, got %v", err)
+	}
+
+	if applied {
+		t.Errorf("Expected apply not to be called")
+	}
+}
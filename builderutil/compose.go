@@ -0,0 +1,139 @@
+package builderutil
+
+// Pipe returns a single configuration function that applies each of fns in order, stopping
+// at the first error. It collapses a group of related mutations into one reusable option
+// without allocating a Lister, making it the function-level sibling of Chain.
+// Nil functions are skipped.
+// Parameters:
+// - fns: Variadic configuration functions to apply in order.
+//
+// Returns:
+// - A configuration function returning the first error from fns, if any.
+func Pipe[T any](fns ...func(*T) error) func(*T) error {
+
+	fns = append([]func(*T) error(nil), fns...)
+
+	return func(t *T) error {
+
+		for _, fn := range fns {
+			if fn == nil {
+				continue
+			}
+
+			if err := fn(t); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// Sub returns a configuration function of Parent that builds a Child from childOpts and, if
+// the build succeeds, assigns it to the parent with set. It enables hierarchical configuration,
+// where a fully-configured sub-object is a single option of its parent. The child is built
+// anew every time the returned function is applied.
+// Parameters:
+// - set: Stores the built child into the parent.
+// - childOpts: Variadic arguments of type Lister[Child] that configure the child.
+//
+// Returns:
+// - A configuration function of Parent returning the child build error, if any.
+func Sub[Parent any, Child any](set func(*Parent, *Child), childOpts ...Lister[Child]) func(*Parent) error {
+
+	childOpts = append([]Lister[Child](nil), childOpts...)
+
+	return func(p *Parent) error {
+
+		child, err := Build(childOpts...)
+		if err != nil {
+			return err
+		}
+
+		set(p, child)
+
+		return nil
+	}
+}
+
+// Tee applies each configuration function to both a and b in lockstep, for example to build
+// a primary and a shadow instance from the same options. Each function runs on a and then on
+// b before the next function starts, and the first error from either target stops both.
+// If the functions are deterministic and both targets start equal, they end up structurally
+// identical. Nil functions are skipped.
+// Parameters:
+// - a: The first instance to configure. It must not be nil.
+// - b: The second instance to configure. It must not be nil.
+// - fns: Variadic configuration functions to apply in order.
+//
+// Returns:
+// - ErrNilTarget if either target is nil, or the first error returned by a function.
+func Tee[T any](a, b *T, fns ...func(*T) error) error {
+
+	if a == nil || b == nil {
+		return ErrNilTarget
+	}
+
+	for _, fn := range fns {
+		if fn == nil {
+			continue
+		}
+
+		if err := fn(a); err != nil {
+			return err
+		}
+
+		if err := fn(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MapError returns a configuration function that applies fn and, if it fails, passes the
+// error through wrap, for example to add domain-specific context such as
+// "failed to configure TLS: ...". wrap is only called on error; a nil wrap leaves the error
+// unchanged.
+// Parameters:
+// - fn: The configuration function to apply.
+// - wrap: Transforms the error returned by fn.
+//
+// Returns:
+// - A configuration function returning nil or the transformed error.
+func MapError[T any](fn func(*T) error, wrap func(error) error) func(*T) error {
+	return func(t *T) error {
+
+		err := fn(t)
+		if err != nil && wrap != nil {
+			return wrap(err)
+		}
+
+		return err
+	}
+}
+
+// Decorate returns a Lister that wraps every function of opts with middleware, the option
+// analog of HTTP middleware for cross-cutting concerns such as logging, metrics or retries.
+// middleware receives the original function as next and returns its replacement, which may
+// run code around next, change its error, or short-circuit by returning without calling it.
+// Nil Listers and nil functions are skipped without being passed to middleware.
+//
+// Wrapping happens each time the returned Lister's List method is called. When decorations
+// are nested, the outermost Decorate is the outermost layer: with
+// Decorate(a, Decorate(b, opts)), every function runs as a(b(fn)). A nil middleware leaves
+// the functions unchanged.
+// Parameters:
+// - middleware: Wraps a single configuration function.
+// - opts: Variadic Listers whose functions are decorated, in application order.
+//
+// Returns:
+// - A Lister whose List method returns the decorated functions of all Listers in order.
+func Decorate[T any](middleware func(next func(*T) error) func(*T) error, opts ...Lister[T]) Lister[T] {
+
+	if middleware == nil {
+		return Chain(opts...)
+	}
+
+	return Chain(wrapAll(middleware, opts)...)
+}
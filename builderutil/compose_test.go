@@ -0,0 +1,281 @@
+package builderutil_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// composeConfig is a configuration type recording the order in which functions ran.
+type composeConfig struct {
+	Steps []string
+}
+
+// step returns a configuration function appending name to the recorded steps.
+func step(name string) func(*composeConfig) error {
+	return func(c *composeConfig) error {
+		c.Steps = append(c.Steps, name)
+		return nil
+	}
+}
+
+// TestPipe tests if Pipe applies the functions in order and skips nil entries.
+func TestPipe(t *testing.T) {
+	piped := builderutil.Pipe(step("a"), nil, step("b"), step("c"))
+
+	config, err := builderutil.Build[composeConfig](builderutil.Options[composeConfig]{piped})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+}
+
+// TestPipe_ShortCircuit tests if Pipe stops at a failing middle function.
+func TestPipe_ShortCircuit(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	errFunc := func(*composeConfig) error {
+		return errFailed
+	}
+
+	config := &composeConfig{}
+
+	err := builderutil.Pipe(step("a"), errFunc, step("b"))(config)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	expected := []string{"a"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+}
+
+// TestSub tests if Sub populates a child field from a nested option set.
+func TestSub(t *testing.T) {
+	type TLS struct {
+		MinVersion string
+	}
+	type Server struct {
+		Addr string
+		TLS  *TLS
+	}
+
+	setMinVersion := func(c *TLS) error {
+		c.MinVersion = "1.3"
+		return nil
+	}
+	setTLS := func(s *Server, c *TLS) {
+		s.TLS = c
+	}
+
+	server, err := builderutil.Build[Server](builderutil.Options[Server]{
+		builderutil.Sub(setTLS, builderutil.Options[TLS]{setMinVersion}),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if server.TLS == nil || server.TLS.MinVersion != "1.3" {
+		t.Errorf("Expected TLS.MinVersion to be 1.3, got %+v", server.TLS)
+	}
+}
+
+// TestSub_ChildError tests if a child build error bubbles up to the parent build.
+func TestSub_ChildError(t *testing.T) {
+	type Child struct {
+		Value int
+	}
+	type Parent struct {
+		Child *Child
+	}
+
+	errFailed := errors.New("error in child")
+
+	errFunc := func(*Child) error {
+		return errFailed
+	}
+
+	called := false
+	setChild := func(p *Parent, c *Child) {
+		called = true
+	}
+
+	_, err := builderutil.Build[Parent](builderutil.Options[Parent]{
+		builderutil.Sub(setChild, builderutil.Options[Child]{errFunc}),
+	})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if called {
+		t.Error("Expected set not to be called when the child build fails")
+	}
+}
+
+// TestTee tests if both targets receive the same mutations.
+func TestTee(t *testing.T) {
+	primary := &composeConfig{}
+	shadow := &composeConfig{}
+
+	if err := builderutil.Tee(primary, shadow, step("a"), nil, step("b")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"a", "b"}
+	if !reflect.DeepEqual(primary.Steps, expected) || !reflect.DeepEqual(shadow.Steps, expected) {
+		t.Errorf("Expected both targets to have steps %v, got %v and %v", expected, primary.Steps, shadow.Steps)
+	}
+}
+
+// TestTee_Error tests if an error on the second target halts the application on both.
+func TestTee_Error(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	primary := &composeConfig{}
+	shadow := &composeConfig{}
+
+	failOnShadow := func(c *composeConfig) error {
+		if c == shadow {
+			return errFailed
+		}
+		return nil
+	}
+
+	err := builderutil.Tee(primary, shadow, step("a"), failOnShadow, step("b"))
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	// Verify that neither target received the function after the failure
+	expected := []string{"a"}
+	if !reflect.DeepEqual(primary.Steps, expected) || !reflect.DeepEqual(shadow.Steps, expected) {
+		t.Errorf("Expected both targets to have steps %v, got %v and %v", expected, primary.Steps, shadow.Steps)
+	}
+}
+
+// TestTee_NilTarget tests if Tee rejects a nil target.
+func TestTee_NilTarget(t *testing.T) {
+	if err := builderutil.Tee(&composeConfig{}, nil, step("a")); !errors.Is(err, builderutil.ErrNilTarget) {
+		t.Fatalf("Expected ErrNilTarget, got %v", err)
+	}
+}
+
+// TestMapError tests if MapError wraps only failures and keeps the original error reachable.
+func TestMapError(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	wraps := 0
+	wrap := func(err error) error {
+		wraps++
+		return fmt.Errorf("failed to configure TLS: %w", err)
+	}
+	errFunc := func(*composeConfig) error {
+		return errFailed
+	}
+
+	// A successful function is not wrapped
+	if err := builderutil.MapError(step("a"), wrap)(&composeConfig{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if wraps != 0 {
+		t.Errorf("Expected wrap not to be called on success, got %d calls", wraps)
+	}
+
+	err := builderutil.MapError(errFunc, wrap)(&composeConfig{})
+	if err == nil || err.Error() != "failed to configure TLS: error in function" {
+		t.Fatalf("Expected wrapped error, got %v", err)
+	}
+	if errors.Unwrap(err) != errFailed {
+		t.Errorf("Expected errors.Unwrap to reach %v, got %v", errFailed, errors.Unwrap(err))
+	}
+}
+
+// TestMapError_NilWrap tests if a nil wrap leaves the error unchanged.
+func TestMapError_NilWrap(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	errFunc := func(*composeConfig) error {
+		return errFailed
+	}
+
+	if err := builderutil.MapError(errFunc, nil)(&composeConfig{}); err != errFailed {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+}
+
+// TestDecorate tests if the middleware runs once per option function and preserves order.
+func TestDecorate(t *testing.T) {
+	var calls int
+	counting := func(next func(*composeConfig) error) func(*composeConfig) error {
+		return func(c *composeConfig) error {
+			calls++
+			return next(c)
+		}
+	}
+	tagging := func(next func(*composeConfig) error) func(*composeConfig) error {
+		return func(c *composeConfig) error {
+			c.Steps = append(c.Steps, "[")
+			err := next(c)
+			c.Steps = append(c.Steps, "]")
+			return err
+		}
+	}
+
+	decorated := builderutil.Decorate(tagging, builderutil.Decorate(counting,
+		builderutil.Options[composeConfig]{step("a"), nil},
+		nil,
+		builderutil.Options[composeConfig]{step("b")},
+	))
+
+	config, err := builderutil.Build(decorated)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected the middleware to run 2 times, got %d", calls)
+	}
+
+	expected := []string{"[", "a", "]", "[", "b", "]"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+}
+
+// TestDecorate_ShortCircuit tests if the middleware can short-circuit and replace errors.
+func TestDecorate_ShortCircuit(t *testing.T) {
+	errDenied := errors.New("denied")
+
+	deny := func(next func(*composeConfig) error) func(*composeConfig) error {
+		return func(*composeConfig) error {
+			return errDenied
+		}
+	}
+
+	var ran bool
+	option := builderutil.Options[composeConfig]{func(*composeConfig) error {
+		ran = true
+		return nil
+	}}
+
+	_, err := builderutil.Build(builderutil.Decorate(deny, option))
+	if !errors.Is(err, errDenied) {
+		t.Fatalf("Expected %v, got %v", errDenied, err)
+	}
+	if ran {
+		t.Errorf("Expected the option not to run")
+	}
+
+	config, err := builderutil.Build(builderutil.Decorate(nil, builderutil.Options[composeConfig]{step("a")}))
+	if err != nil || len(config.Steps) != 1 {
+		t.Errorf("Expected a nil middleware to leave the options unchanged, got %v and %v", config, err)
+	}
+}
@@ -0,0 +1,79 @@
+package builderutil
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// BuildCounted is like Build but also reports how many configuration functions were applied
+// successfully, a lightweight diagnostic when full hooks are not needed. Nil Listers and nil
+// functions are skipped and therefore not counted.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - The number of functions that succeeded, which on error excludes the failing function.
+// - A *BuildError for the failing function.
+func BuildCounted[T any](opts ...Lister[T]) (*T, int, error) {
+
+	t := new(T)
+
+	var count int
+
+	err := apply(context.Background(), t, opts, func(_, _ int, fn func(*T) error) error {
+
+		if err := fn(t); err != nil {
+			return err
+		}
+		count++
+
+		return nil
+	})
+	if err != nil {
+		return nil, count, err
+	}
+
+	return t, count, nil
+}
+
+// BuildResult holds the outcome of BuildR in a single value, which is convenient in fuzz
+// harnesses and table-driven tests and can be serialized for corpus inspection.
+type BuildResult[T any] struct {
+	// Value is the constructed instance, or nil if the build failed.
+	Value *T `json:"value"`
+	// Applied is the number of configuration functions that succeeded.
+	Applied int `json:"applied"`
+	// Err is the error that stopped the build, or nil on success.
+	Err error `json:"-"`
+}
+
+// MarshalJSON encodes the result with Err rendered as its message, or null on success.
+func (r BuildResult[T]) MarshalJSON() ([]byte, error) {
+
+	type plain BuildResult[T]
+
+	var msg *string
+	if r.Err != nil {
+		s := r.Err.Error()
+		msg = &s
+	}
+
+	return json.Marshal(struct {
+		plain
+		Err *string `json:"error"`
+	}{plain: plain(r), Err: msg})
+}
+
+// BuildR is like BuildCounted but returns its results as a single BuildResult.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - The constructed instance, the number of applied functions and the error of the build.
+func BuildR[T any](opts ...Lister[T]) BuildResult[T] {
+
+	t, applied, err := BuildCounted(opts...)
+
+	return BuildResult[T]{Value: t, Applied: applied, Err: err}
+}
@@ -0,0 +1,165 @@
+package builderutil_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildCounted tests if BuildCounted counts applied functions and excludes nil ones.
+func TestBuildCounted(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	increment := func(c *Config) error {
+		c.Value++
+		return nil
+	}
+
+	config, count, err := builderutil.BuildCounted[Config](builderutil.Options[Config]{increment, nil, increment}, nil, builderutil.Options[Config]{increment})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if count != 3 {
+		t.Errorf("Expected count to be 3, got %d", count)
+	}
+	if config.Value != 3 {
+		t.Errorf("Expected config.Value to be 3, got %d", config.Value)
+	}
+}
+
+// TestBuildCounted_Error tests if BuildCounted reports the functions applied before a failure.
+func TestBuildCounted_Error(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	increment := func(c *Config) error {
+		c.Value++
+		return nil
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	config, count, err := builderutil.BuildCounted[Config](builderutil.Options[Config]{increment, increment, errFunc, increment})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if config != nil {
+		t.Errorf("Expected nil config, got %+v", config)
+	}
+	if count != 2 {
+		t.Errorf("Expected count to be 2, got %d", count)
+	}
+}
+
+// TestBuildR tests if BuildR reports the instance, applied count and error, and serializes them.
+func TestBuildR(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	increment := func(c *Config) error {
+		c.Value++
+		return nil
+	}
+
+	result := builderutil.BuildR[Config](builderutil.Options[Config]{increment, nil, increment})
+	if result.Err != nil {
+		t.Fatalf("Expected no error, got %v", result.Err)
+	}
+	if result.Applied != 2 || result.Value.Value != 2 {
+		t.Errorf("Expected 2 applied functions and Value 2, got %+v", result)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(data) != `{"value":{"Value":2},"applied":2,"error":null}` {
+		t.Errorf("Expected serialized success result, got %s", data)
+	}
+
+	failed := builderutil.BuildR[Config](builderutil.Options[Config]{increment, func(*Config) error {
+		return errors.New("boom")
+	}})
+	data, err = json.Marshal(failed)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(data) != `{"value":null,"applied":1,"error":"builderutil: option 1 of lister 0 failed: boom"}` {
+		t.Errorf("Expected serialized failure result, got %s", data)
+	}
+}
+
+// FuzzBuildR tests if random option combinations never panic and always produce a consistent result.
+func FuzzBuildR(f *testing.F) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	f.Add([]byte{})
+	f.Add([]byte{0, 1, 2, 3})
+	f.Add([]byte{2, 2, 4, 2, 3, 2})
+
+	f.Fuzz(func(t *testing.T, program []byte) {
+		var opts []builderutil.Lister[Config]
+		var funcs builderutil.Options[Config]
+
+		want, failed := 0, false
+
+		flush := func() {
+			opts = append(opts, funcs)
+			funcs = nil
+		}
+
+		for _, op := range program {
+			switch op % 5 {
+			case 0:
+				opts = append(opts, nil)
+			case 1:
+				funcs = append(funcs, nil)
+			case 2:
+				funcs = append(funcs, func(c *Config) error {
+					c.Value++
+					return nil
+				})
+				if !failed {
+					want++
+				}
+			case 3:
+				funcs = append(funcs, func(*Config) error {
+					return errFailed
+				})
+				failed = true
+			case 4:
+				flush()
+			}
+		}
+		flush()
+
+		result := builderutil.BuildR(opts...)
+
+		if failed {
+			if !errors.Is(result.Err, errFailed) || result.Value != nil {
+				t.Fatalf("Expected %v and no value, got %+v", errFailed, result)
+			}
+		} else if result.Err != nil || result.Value == nil || result.Value.Value != want {
+			t.Fatalf("Expected Value %d and no error, got %+v", want, result)
+		}
+
+		if result.Applied != want {
+			t.Fatalf("Expected %d applied functions, got %d", want, result.Applied)
+		}
+	})
+}
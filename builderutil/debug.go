@@ -0,0 +1,52 @@
+package builderutil
+
+import (
+	"context"
+	"reflect"
+)
+
+// BuildDebug is like Build but gives richer diagnostics when an option fails: the target is
+// deep-copied before every configuration function, and if a function fails after changing
+// exactly one field, the returned *BuildError reports that field through FieldPath and in
+// its message, for example "failed while setting TLS.MinVersion". Paths descend into nested
+// structs and non-nil pointers to structs. The snapshots make this considerably slower than
+// Build, so it is meant for development and troubleshooting.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - ErrNotStruct if T is not a struct, or a *BuildError for the failing function.
+func BuildDebug[T any](opts ...Lister[T]) (*T, error) {
+
+	t := new(T)
+
+	rv, err := structValue(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var path string
+
+	err = apply(context.Background(), t, opts, func(_, _ int, fn func(*T) error) error {
+
+		before := reflect.ValueOf(deepClone(t)).Elem()
+
+		err := fn(t)
+		if err != nil {
+			if paths := changedPaths(before, rv, ""); len(paths) == 1 {
+				path = paths[0]
+			}
+		}
+
+		return err
+	})
+	if err != nil {
+		if buildErr, ok := err.(*BuildError); ok {
+			buildErr.path = path
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
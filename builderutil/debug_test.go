@@ -0,0 +1,131 @@
+package builderutil_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// debugTLS is a nested struct of debugConfig.
+type debugTLS struct {
+	MinVersion string
+	Ciphers    []string
+}
+
+// debugConfig is a configuration type used by the BuildDebug tests.
+type debugConfig struct {
+	Host string
+	Port int
+	TLS  *debugTLS
+}
+
+// TestBuildDebug_FieldPath tests if the field path is reported for an option that set a single field.
+func TestBuildDebug_FieldPath(t *testing.T) {
+	errUnsupported := errors.New("unsupported version")
+
+	enableTLS := func(c *debugConfig) error {
+		c.TLS = &debugTLS{}
+		return nil
+	}
+	setVersion := func(c *debugConfig) error {
+		c.TLS.MinVersion = "1.0"
+		return errUnsupported
+	}
+
+	_, err := builderutil.BuildDebug[debugConfig](builderutil.Options[debugConfig]{enableTLS, setVersion})
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) {
+		t.Fatalf("Expected *BuildError, got %v", err)
+	}
+
+	if path := buildErr.FieldPath(); path != "TLS.MinVersion" {
+		t.Errorf("Expected field path 'TLS.MinVersion', got '%s'", path)
+	}
+	if !strings.Contains(err.Error(), "while setting TLS.MinVersion") || !errors.Is(err, errUnsupported) {
+		t.Errorf("Expected the message to name the field and wrap the error, got %v", err)
+	}
+}
+
+// TestBuildDebug_MultipleFields tests if no field path is reported for an option that set several fields.
+func TestBuildDebug_MultipleFields(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	setBoth := func(c *debugConfig) error {
+		c.Host = "localhost"
+		c.Port = 8080
+		return errFailed
+	}
+	setNothing := func(*debugConfig) error {
+		return errFailed
+	}
+
+	for _, fn := range []func(*debugConfig) error{setBoth, setNothing} {
+		_, err := builderutil.BuildDebug[debugConfig](builderutil.Options[debugConfig]{fn})
+
+		var buildErr *builderutil.BuildError
+		if !errors.As(err, &buildErr) {
+			t.Fatalf("Expected *BuildError, got %v", err)
+		}
+		if path := buildErr.FieldPath(); path != "" {
+			t.Errorf("Expected no field path, got '%s'", path)
+		}
+	}
+
+	// Build never determines a field path
+	_, err := builderutil.Build[debugConfig](builderutil.Options[debugConfig]{func(c *debugConfig) error {
+		c.Host = "localhost"
+		return errFailed
+	}})
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) || buildErr.FieldPath() != "" {
+		t.Errorf("Expected *BuildError without field path, got %v", err)
+	}
+}
+
+// TestBuildDebug_Success tests if BuildDebug builds like Build when every option succeeds.
+func TestBuildDebug_Success(t *testing.T) {
+	config, err := builderutil.BuildDebug[debugConfig](builderutil.Options[debugConfig]{func(c *debugConfig) error {
+		c.Port = 8080
+		return nil
+	}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Port != 8080 {
+		t.Errorf("Expected config.Port to be 8080, got %d", config.Port)
+	}
+}
+
+// TestBuildDebug_FuncField tests if an unchanged func field does not hide the field path.
+func TestBuildDebug_FuncField(t *testing.T) {
+	type Config struct {
+		OnErr func(error)
+		Port  int
+	}
+
+	errInvalid := errors.New("invalid port")
+
+	setOnErr := func(c *Config) error {
+		c.OnErr = func(error) {}
+		return nil
+	}
+	setPort := func(c *Config) error {
+		c.Port = -1
+		return errInvalid
+	}
+
+	_, err := builderutil.BuildDebug[Config](builderutil.Options[Config]{setOnErr, setPort})
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) {
+		t.Fatalf("Expected *BuildError, got %v", err)
+	}
+
+	if buildErr.FieldPath() != "Port" {
+		t.Errorf("Expected field path 'Port', got '%s'", buildErr.FieldPath())
+	}
+}
@@ -0,0 +1,46 @@
+package builderutil
+
+import "encoding/json"
+
+// FromDecoder returns a Lister whose single function decodes a document onto the target.
+// The decoding runs when the option is applied: decode is called with a pointer to a deep
+// staging copy of the target, which is copied back only if decode succeeds, so a document
+// failing partway leaves the target untouched even if decode had already merged into its
+// maps or slices. On success the pointer, slice and map fields of the target refer to the
+// copies made for decoding. Decoders that leave absent keys untouched, such as encoding/json,
+// therefore only override the fields present in the document and keep every other field as
+// set by earlier options. It is the building block for format-specific loaders like FromJSON.
+// Parameters:
+// - decode: Decodes the document into the value it is given, which is always a *T.
+//
+// Returns:
+// - A Lister whose single function returns the decoding error, if any.
+func FromDecoder[T any](decode func(v any) error) Lister[T] {
+	return Options[T]{func(t *T) error {
+
+		staged := deepClone(t)
+
+		if err := decode(staged); err != nil {
+			return err
+		}
+
+		cloneInto(t, staged)
+
+		return nil
+	}}
+}
+
+// FromJSON returns a Lister that applies the JSON document data onto the target, bridging
+// file-based configuration and functional options. Only the fields present in the document
+// are changed. Malformed JSON is reported as an error from the option function during Build,
+// in which case the target is left untouched.
+// Parameters:
+// - data: The JSON document to decode.
+//
+// Returns:
+// - A Lister whose single function decodes data onto the target.
+func FromJSON[T any](data []byte) Lister[T] {
+	return FromDecoder[T](func(v any) error {
+		return json.Unmarshal(data, v)
+	})
+}
@@ -0,0 +1,100 @@
+package builderutil_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// jsonConfig is a configuration type loaded from JSON documents.
+type jsonConfig struct {
+	Name string `json:"name"`
+	Port int    `json:"port"`
+}
+
+// TestFromJSON tests if FromJSON populates fields from a valid document.
+func TestFromJSON(t *testing.T) {
+	config, err := builderutil.Build[jsonConfig](builderutil.FromJSON[jsonConfig]([]byte(`{"name":"api","port":8080}`)))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := jsonConfig{Name: "api", Port: 8080}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+}
+
+// TestFromJSON_Invalid tests if malformed JSON surfaces as an error during Build.
+func TestFromJSON_Invalid(t *testing.T) {
+	_, err := builderutil.Build[jsonConfig](builderutil.FromJSON[jsonConfig]([]byte(`{"name":`)))
+
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("Expected *json.SyntaxError, got %v", err)
+	}
+}
+
+// TestFromJSON_Partial tests if a partial document only overrides the fields it contains.
+func TestFromJSON_Partial(t *testing.T) {
+	setName := func(c *jsonConfig) error {
+		c.Name = "default"
+		c.Port = 80
+		return nil
+	}
+
+	config, err := builderutil.Build[jsonConfig](
+		builderutil.Options[jsonConfig]{setName},
+		builderutil.FromJSON[jsonConfig]([]byte(`{"port":8080}`)),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := jsonConfig{Name: "default", Port: 8080}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+}
+
+// TestFromDecoder_LeavesTargetOnError tests if a failing decoder leaves the target untouched.
+func TestFromDecoder_LeavesTargetOnError(t *testing.T) {
+	errDecode := errors.New("decode failed")
+
+	decode := func(v any) error {
+		v.(*jsonConfig).Name = "partial"
+		return errDecode
+	}
+
+	config := &jsonConfig{Name: "original"}
+
+	err := builderutil.BuildInto[jsonConfig](config, builderutil.FromDecoder[jsonConfig](decode))
+	if !errors.Is(err, errDecode) {
+		t.Fatalf("Expected %v, got %v", errDecode, err)
+	}
+
+	if config.Name != "original" {
+		t.Errorf("Expected config.Name to be original, got %s", config.Name)
+	}
+}
+
+// TestFromJSON_LeavesMapOnError tests if a document failing partway leaves a pre-populated map untouched.
+func TestFromJSON_LeavesMapOnError(t *testing.T) {
+	type mapConfig struct {
+		Tags map[string]string
+		Big  int
+	}
+
+	config := &mapConfig{Tags: map[string]string{"a": "1"}}
+
+	err := builderutil.BuildInto[mapConfig](config, builderutil.FromJSON[mapConfig]([]byte(`{"Tags":{"a":"X"},"Big":"notanint"}`)))
+	if err == nil {
+		t.Fatalf("Expected an error, got nil")
+	}
+
+	if config.Tags["a"] != "1" {
+		t.Errorf("Expected config.Tags[a] to be '1', got '%s'", config.Tags["a"])
+	}
+}
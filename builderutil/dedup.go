@@ -0,0 +1,42 @@
+package builderutil
+
+import "reflect"
+
+// BuildDedup is like Build but drops repeated Listers before applying them, so a bundle
+// included from several sources runs only once. Listers are identified by pointer identity:
+// only the first occurrence of a pointer is kept. Listers that are not pointers, such as
+// Options, cannot be compared reliably and are always kept.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError whose ListerIndex is the position of the failing Lister in opts.
+func BuildDedup[T any](opts ...Lister[T]) (*T, error) {
+
+	type identity struct {
+		typ reflect.Type
+		ptr uintptr
+	}
+
+	seen := make(map[identity]struct{}, len(opts))
+	perm := make([]int, 0, len(opts))
+
+	for i, opt := range opts {
+		if isNilLister(opt) {
+			continue
+		}
+
+		if rv := reflect.ValueOf(opt); rv.Kind() == reflect.Pointer {
+			id := identity{typ: rv.Type(), ptr: rv.Pointer()}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+		}
+
+		perm = append(perm, i)
+	}
+
+	return applyPermuted(opts, perm)
+}
@@ -0,0 +1,52 @@
+package builderutil_test
+
+import (
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildDedup tests if a lister passed twice is applied once while distinct listers all apply.
+func TestBuildDedup(t *testing.T) {
+	type Config struct {
+		Count int
+	}
+
+	increment := func(c *Config) error {
+		c.Count++
+		return nil
+	}
+
+	shared := &MockLister[Config]{Funcs: []func(*Config) error{increment}}
+	distinct := &MockLister[Config]{Funcs: []func(*Config) error{increment}}
+
+	config, err := builderutil.BuildDedup[Config](shared, distinct, shared, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Count != 2 {
+		t.Errorf("Expected config.Count to be 2, got %d", config.Count)
+	}
+}
+
+// TestBuildDedup_NonPointer tests if non-pointer listers are always kept.
+func TestBuildDedup_NonPointer(t *testing.T) {
+	type Config struct {
+		Count int
+	}
+
+	options := builderutil.Options[Config]{func(c *Config) error {
+		c.Count++
+		return nil
+	}}
+
+	config, err := builderutil.BuildDedup[Config](options, options)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Count != 2 {
+		t.Errorf("Expected config.Count to be 2, got %d", config.Count)
+	}
+}
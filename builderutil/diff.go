@@ -0,0 +1,231 @@
+package builderutil
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// equalValues reports whether a and b, two values of the same type, are equal. Values are
+// compared with reflect.DeepEqual, except that functions, which DeepEqual never considers equal
+// unless both are nil, are compared by identity. Func fields of structs whose fields are all
+// exported, and func elements of arrays, are compared by identity too.
+func equalValues(a, b reflect.Value) bool {
+
+	switch a.Kind() {
+	case reflect.Func:
+		return a.IsNil() == b.IsNil() && a.Pointer() == b.Pointer()
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !a.Type().Field(i).IsExported() {
+				return reflect.DeepEqual(a.Interface(), b.Interface())
+			}
+		}
+
+		for i := 0; i < a.NumField(); i++ {
+			if !equalValues(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if !equalValues(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+}
+
+// changedFields returns the indices of the exported top-level fields that differ between
+// the structs a and b, in declaration order. Fields are compared with equalValues.
+func changedFields(a, b reflect.Value) []int {
+
+	var changed []int
+
+	for i := 0; i < a.NumField(); i++ {
+		if !a.Type().Field(i).IsExported() {
+			continue
+		}
+
+		if !equalValues(a.Field(i), b.Field(i)) {
+			changed = append(changed, i)
+		}
+	}
+
+	return changed
+}
+
+// changedPaths returns the dotted paths of the exported fields that differ between the
+// structs a and b, in declaration order. Nested structs, and pointers to structs that are
+// non-nil on both sides, are descended into, so only the innermost changed fields are reported.
+// Fields are compared with equalValues.
+func changedPaths(a, b reflect.Value, prefix string) []string {
+
+	var paths []string
+
+	for i := 0; i < a.NumField(); i++ {
+		sf := a.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		fa, fb := a.Field(i), b.Field(i)
+		if fa.Kind() == reflect.Pointer && !fa.IsNil() && !fb.IsNil() && fa.Elem().Kind() == reflect.Struct {
+			fa, fb = fa.Elem(), fb.Elem()
+		}
+
+		switch {
+		case equalValues(fa, fb):
+		case fa.Kind() == reflect.Struct:
+			paths = append(paths, changedPaths(fa, fb, prefix+sf.Name+".")...)
+		default:
+			paths = append(paths, prefix+sf.Name)
+		}
+	}
+
+	return paths
+}
+
+// DryRun reports the mutations the options would make without applying them to any real
+// target. The options are applied to a throwaway zero instance of T, which is then compared
+// field by field with a zero value. Only exported top-level fields are reported.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - One human-readable "Field: old -> new" line per changed field, in declaration order.
+// - ErrNotStruct if T is not a struct, or an error if any configuration function fails.
+func DryRun[T any](opts ...Lister[T]) ([]string, error) {
+
+	t, err := Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := structValue(t)
+	if err != nil {
+		return nil, err
+	}
+
+	before := reflect.New(after.Type()).Elem()
+
+	var changes []string
+	for _, i := range changedFields(before, after) {
+		changes = append(changes, fmt.Sprintf("%s: %v -> %v", after.Type().Field(i).Name, before.Field(i), after.Field(i)))
+	}
+
+	return changes, nil
+}
+
+// BuildDiff reconfigures a live object with the minimal set of writes. The options are applied
+// to a deep copy of current, the copy is compared with current field by field, and only the
+// fields that differ are written back. Fields the options left unchanged, or set to the value
+// they already had, are never written, which avoids churn on objects read by other code. Since
+// the copy is deep, options that mutate maps or slices in place are detected as changes too,
+// and a written field refers to the copied data. Only exported top-level fields are compared;
+// if any option fails, current is left untouched.
+// Parameters:
+// - current: A pointer to the live instance of T to update. It must not be nil.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - The names of the fields written to current, in declaration order.
+// - ErrNilTarget if current is nil, a *FrozenError, ErrNotStruct if T is not a struct, or a *BuildError.
+func BuildDiff[T any](current *T, opts ...Lister[T]) (changed []string, err error) {
+
+	if current == nil {
+		return nil, ErrNilTarget
+	}
+
+	before, err := settableStruct(current)
+	if err != nil {
+		return nil, err
+	}
+
+	staged := deepClone(current)
+
+	if err := apply(context.Background(), staged, opts, nil); err != nil {
+		return nil, err
+	}
+
+	after := reflect.ValueOf(staged).Elem()
+
+	for _, i := range changedFields(before, after) {
+		before.Field(i).Set(after.Field(i))
+		changed = append(changed, before.Type().Field(i).Name)
+	}
+
+	return changed, nil
+}
+
+// delta is a NamedLister setting the fields in which two versions of a struct differ.
+type delta[T any] struct {
+	names []string
+	fns   Options[T]
+}
+
+// List returns one function per changed field.
+func (d delta[T]) List() []func(*T) error {
+	return d.fns
+}
+
+// Names returns the names of the changed fields.
+func (d delta[T]) Names() []string {
+	return d.names
+}
+
+// DeltaLister captures the changes that turn from into to, for example to migrate configuration
+// from one version to the next, and returns them as a Lister that can be replayed. The exported
+// top-level fields of from and to are compared when DeltaLister is called, and the Lister holds
+// one function per differing field that assigns a deep copy of its value in to, so applying it
+// to a value equal to from yields a value equal to to. Fields that do not differ are left
+// untouched; func fields differ only if they are not the same function. The returned Lister also implements NamedLister, naming each function after its
+// field. A nil from or to is treated as a zero value.
+// Parameters:
+// - from: The original version.
+// - to: The target version.
+//
+// Returns:
+// - A Lister setting every changed field, or whose single function returns ErrNotStruct.
+func DeltaLister[T any](from, to *T) Lister[T] {
+
+	if from == nil {
+		from = new(T)
+	}
+	if to == nil {
+		to = new(T)
+	}
+
+	before, err := structValue(from)
+	if err != nil {
+		return Options[T]{func(*T) error { return err }}
+	}
+
+	after := reflect.ValueOf(deepClone(to)).Elem()
+
+	var d delta[T]
+	for _, i := range changedFields(before, after) {
+		i := i
+
+		d.names = append(d.names, after.Type().Field(i).Name)
+		d.fns = append(d.fns, func(t *T) error {
+
+			rv, err := settableStruct(t)
+			if err != nil {
+				return err
+			}
+
+			deepCopy(rv.Field(i), after.Field(i), make(map[pointerKey]reflect.Value))
+
+			return nil
+		})
+	}
+
+	return d
+}
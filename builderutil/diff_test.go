@@ -0,0 +1,260 @@
+package builderutil_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// diffConfig is a configuration type used by the diff-based tests.
+type diffConfig struct {
+	Host  string
+	Port  int
+	Debug bool
+	Tags  []string
+}
+
+// TestDryRun tests if DryRun lists exactly the fields the options changed.
+func TestDryRun(t *testing.T) {
+	setHost := func(c *diffConfig) error {
+		c.Host = "localhost"
+		return nil
+	}
+	setTags := func(c *diffConfig) error {
+		c.Tags = []string{"a"}
+		return nil
+	}
+	keepDebug := func(c *diffConfig) error {
+		c.Debug = false
+		return nil
+	}
+
+	changes, err := builderutil.DryRun[diffConfig](builderutil.Options[diffConfig]{setHost, keepDebug, setTags})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"Host:  -> localhost", "Tags: [] -> [a]"}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected changes %q, got %q", expected, changes)
+	}
+}
+
+// TestDryRun_Error tests if DryRun returns the error of a failing option.
+func TestDryRun_Error(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	errFunc := func(*diffConfig) error {
+		return errFailed
+	}
+
+	_, err := builderutil.DryRun[diffConfig](builderutil.Options[diffConfig]{errFunc})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+}
+
+// TestDryRun_NotStruct tests if DryRun rejects non-struct types.
+func TestDryRun_NotStruct(t *testing.T) {
+	_, err := builderutil.DryRun[int]()
+	if !errors.Is(err, builderutil.ErrNotStruct) {
+		t.Fatalf("Expected ErrNotStruct, got %v", err)
+	}
+}
+
+// TestBuildDiff tests if BuildDiff writes only the differing fields and reports their names.
+func TestBuildDiff(t *testing.T) {
+	tags := []string{"a"}
+	current := &diffConfig{Host: "localhost", Port: 80, Tags: tags}
+
+	setHost := func(c *diffConfig) error {
+		c.Host = "localhost"
+		return nil
+	}
+	setPort := func(c *diffConfig) error {
+		c.Port = 8080
+		return nil
+	}
+	setDebug := func(c *diffConfig) error {
+		c.Debug = true
+		return nil
+	}
+
+	changed, err := builderutil.BuildDiff(current, builderutil.Options[diffConfig]{setHost, setPort, setDebug})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"Port", "Debug"}
+	if !reflect.DeepEqual(changed, expected) {
+		t.Errorf("Expected changed fields %q, got %q", expected, changed)
+	}
+
+	if current.Port != 8080 || !current.Debug || current.Host != "localhost" {
+		t.Errorf("Expected Port 8080, Debug true and Host 'localhost', got %+v", current)
+	}
+
+	if &current.Tags[0] != &tags[0] {
+		t.Errorf("Expected Tags to keep its backing array")
+	}
+}
+
+// TestBuildDiff_Error tests if a failing option leaves the current value untouched.
+func TestBuildDiff_Error(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	current := &diffConfig{Port: 80}
+
+	setPort := func(c *diffConfig) error {
+		c.Port = 8080
+		return nil
+	}
+	errFunc := func(*diffConfig) error {
+		return errFailed
+	}
+
+	changed, err := builderutil.BuildDiff(current, builderutil.Options[diffConfig]{setPort, errFunc})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if changed != nil || current.Port != 80 {
+		t.Errorf("Expected current to be untouched, got %+v and changes %q", current, changed)
+	}
+
+	if _, err := builderutil.BuildDiff[diffConfig](nil); !errors.Is(err, builderutil.ErrNilTarget) {
+		t.Errorf("Expected ErrNilTarget, got %v", err)
+	}
+}
+
+// TestDeltaLister tests if applying the delta to a copy of from produces a value equal to to.
+func TestDeltaLister(t *testing.T) {
+	from := &diffConfig{Host: "localhost", Port: 80, Tags: []string{"a"}}
+	to := &diffConfig{Host: "localhost", Port: 8080, Debug: true, Tags: []string{"a", "b"}}
+
+	delta := builderutil.DeltaLister(from, to)
+
+	named, ok := delta.(builderutil.NamedLister[diffConfig])
+	if !ok {
+		t.Fatalf("Expected the delta to implement NamedLister")
+	}
+
+	expected := []string{"Port", "Debug", "Tags"}
+	if !reflect.DeepEqual(named.Names(), expected) {
+		t.Errorf("Expected names %q, got %q", expected, named.Names())
+	}
+
+	config, err := builderutil.BuildFrom(*from, delta)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(config, to) {
+		t.Errorf("Expected %+v, got %+v", *to, *config)
+	}
+
+	// Verify that the delta captured to and does not share its data
+	to.Tags[0] = "changed"
+	config.Tags[1] = "changed"
+
+	replayed, err := builderutil.BuildFrom(*from, delta)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(replayed.Tags, []string{"a", "b"}) {
+		t.Errorf("Expected the captured tags [a b], got %v", replayed.Tags)
+	}
+}
+
+// TestDeltaLister_NotStruct tests if DeltaLister reports non-struct types when applied.
+func TestDeltaLister_NotStruct(t *testing.T) {
+	one := 1
+
+	_, err := builderutil.Build(builderutil.DeltaLister(nil, &one))
+	if !errors.Is(err, builderutil.ErrNotStruct) {
+		t.Fatalf("Expected ErrNotStruct, got %v", err)
+	}
+}
+
+// TestBuildDiff_InPlace tests if in-place map mutations are reported and rolled back on error.
+func TestBuildDiff_InPlace(t *testing.T) {
+	type mapConfig struct {
+		Port   int
+		Labels map[string]string
+	}
+
+	errFailed := errors.New("error in function")
+
+	setLabel := func(c *mapConfig) error {
+		c.Labels["a"] = "CHANGED"
+		return nil
+	}
+	errFunc := func(*mapConfig) error {
+		return errFailed
+	}
+
+	current := &mapConfig{Port: 80, Labels: map[string]string{"a": "1"}}
+
+	changed, err := builderutil.BuildDiff(current, builderutil.Options[mapConfig]{setLabel, errFunc})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if changed != nil || current.Labels["a"] != "1" {
+		t.Errorf("Expected current to be untouched, got %+v and changes %q", current, changed)
+	}
+
+	changed, err = builderutil.BuildDiff(current, builderutil.Options[mapConfig]{setLabel})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(changed, []string{"Labels"}) {
+		t.Errorf("Expected changed fields [\"Labels\"], got %q", changed)
+	}
+
+	if current.Labels["a"] != "CHANGED" {
+		t.Errorf("Expected Labels[a] to be 'CHANGED', got '%s'", current.Labels["a"])
+	}
+}
+
+// TestBuildDiff_FuncField tests if a no-op option reports no changes for a type with a func field.
+func TestBuildDiff_FuncField(t *testing.T) {
+	type Config struct {
+		OnErr func(error)
+		Port  int
+	}
+
+	current := &Config{OnErr: func(error) {}, Port: 80}
+
+	noop := func(*Config) error {
+		return nil
+	}
+
+	changed, err := builderutil.BuildDiff(current, builderutil.Options[Config]{noop})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(changed) != 0 {
+		t.Errorf("Expected no changed fields, got %q", changed)
+	}
+}
+
+// TestDeltaLister_FuncField tests if equal values sharing a func field produce an empty delta.
+func TestDeltaLister_FuncField(t *testing.T) {
+	type Config struct {
+		OnErr func(error)
+		Port  int
+	}
+
+	onErr := func(error) {}
+
+	delta := builderutil.DeltaLister(&Config{OnErr: onErr, Port: 80}, &Config{OnErr: onErr, Port: 80})
+
+	if fns := delta.List(); len(fns) != 0 {
+		t.Errorf("Expected an empty delta, got %d functions", len(fns))
+	}
+}
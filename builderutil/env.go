@@ -0,0 +1,23 @@
+package builderutil
+
+import "os"
+
+// FromEnv returns a Lister that populates the exported fields of T tagged with env:"NAME"
+// from the environment variable NAME, for example:
+//
+//	type Config struct {
+//		Addr string `env:"APP_ADDR"`
+//	}
+//
+// Variables are read when the option is applied, not when FromEnv is called, and unset
+// variables leave their field untouched. Values are parsed into the field type; supported
+// types are string, bool, integer, float and time.Duration.
+//
+// Returns:
+// - A Lister whose single function fails with the field and variable name on a parse error.
+func FromEnv[T any]() Lister[T] {
+	return FromTag[T]("env", "environment variable", func(name string) (string, bool, error) {
+		value, ok := os.LookupEnv(name)
+		return value, ok, nil
+	})
+}
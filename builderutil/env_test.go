@@ -0,0 +1,54 @@
+package builderutil_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// envConfig is a configuration type populated from environment variables.
+type envConfig struct {
+	Addr    string `env:"BUILDERUTIL_TEST_ADDR"`
+	Workers int    `env:"BUILDERUTIL_TEST_WORKERS"`
+	Debug   bool   `env:"BUILDERUTIL_TEST_DEBUG"`
+	Region  string `env:"BUILDERUTIL_TEST_REGION"`
+}
+
+// TestFromEnv tests if FromEnv populates string, int and bool fields and skips unset variables.
+func TestFromEnv(t *testing.T) {
+	t.Setenv("BUILDERUTIL_TEST_ADDR", ":8080")
+	t.Setenv("BUILDERUTIL_TEST_WORKERS", "4")
+	t.Setenv("BUILDERUTIL_TEST_DEBUG", "true")
+	os.Unsetenv("BUILDERUTIL_TEST_REGION")
+
+	setRegion := func(c *envConfig) error {
+		c.Region = "eu-west-1"
+		return nil
+	}
+
+	config, err := builderutil.Build[envConfig](builderutil.Options[envConfig]{setRegion}, builderutil.FromEnv[envConfig]())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := envConfig{Addr: ":8080", Workers: 4, Debug: true, Region: "eu-west-1"}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+}
+
+// TestFromEnv_ParseError tests if FromEnv reports the field and variable name on a parse error.
+func TestFromEnv_ParseError(t *testing.T) {
+	t.Setenv("BUILDERUTIL_TEST_WORKERS", "many")
+
+	_, err := builderutil.Build[envConfig](builderutil.FromEnv[envConfig]())
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "Workers") || !strings.Contains(err.Error(), "BUILDERUTIL_TEST_WORKERS") {
+		t.Errorf("Expected error to mention the field and variable, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+// Package errgroup provides a parallel builderutil build on top of golang.org/x/sync/errgroup.
+// It lives in its own module so that the core builderutil package stays dependency-free.
+package errgroup
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// Build constructs an instance of type T by applying each Lister option in its own goroutine
+// of an errgroup.Group derived from ctx. The functions of a single Lister still run
+// sequentially and in order. The first failing function cancels the group's context, and the
+// remaining Listers stop before their next function; nil Listers and nil functions are skipped.
+//
+// Field-level isolation is the caller's responsibility: the Listers must touch disjoint
+// fields of T, otherwise the build contains a data race.
+// Parameters:
+// - ctx: The context that controls cancellation of the build.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *builderutil.BuildError for the first failing function, or the context error.
+func Build[T any](ctx context.Context, opts ...builderutil.Lister[T]) (*T, error) {
+
+	t := new(T)
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	for i, opt := range opts {
+		// Chain skips nil Listers, including typed nil pointers, and calls List only once
+		fns := builderutil.Chain(opt).List()
+		if len(fns) == 0 {
+			continue
+		}
+
+		i := i
+		g.Go(func() error {
+
+			for j, setArgs := range fns {
+
+				if setArgs == nil {
+					continue
+				}
+
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				if err := setArgs(t); err != nil {
+					return &builderutil.BuildError{ListerIndex: i, FuncIndex: j, Err: err}
+				}
+
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -0,0 +1,92 @@
+package errgroup_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+	"github.com/zeroxsolutions/go-utils/builderutil/errgroup"
+)
+
+// Config is a configuration type whose fields are set by disjoint listers.
+type Config struct {
+	A, B, C int
+}
+
+// TestBuild tests if Build aggregates the fields set by every lister.
+func TestBuild(t *testing.T) {
+	setA := builderutil.Options[Config]{func(c *Config) error {
+		c.A = 1
+		return nil
+	}}
+	setB := builderutil.Options[Config]{func(c *Config) error {
+		c.B = 2
+		return nil
+	}, nil}
+	setC := builderutil.Options[Config]{func(c *Config) error {
+		c.C = 3
+		return nil
+	}}
+
+	config, err := errgroup.Build[Config](context.Background(), setA, nil, setB, setC)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := Config{A: 1, B: 2, C: 3}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+}
+
+// TestBuild_Error tests if Build returns the first error and cancels the sibling listers.
+func TestBuild_Error(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	failing := builderutil.Options[Config]{func(*Config) error {
+		return errFailed
+	}}
+
+	var ranAfterCancel bool
+	slow := builderutil.Options[Config]{func(c *Config) error {
+		time.Sleep(50 * time.Millisecond)
+		c.B = 2
+		return nil
+	}, func(c *Config) error {
+		ranAfterCancel = true
+		return nil
+	}}
+
+	config, err := errgroup.Build[Config](context.Background(), slow, failing)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) || buildErr.ListerIndex != 1 {
+		t.Errorf("Expected *BuildError at lister 1, got %v", err)
+	}
+
+	if config != nil {
+		t.Errorf("Expected nil config, got %+v", config)
+	}
+	if ranAfterCancel {
+		t.Errorf("Expected the sibling lister to stop after cancellation")
+	}
+}
+
+// TestBuild_Cancelled tests if Build stops when the parent context is cancelled.
+func TestBuild_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := errgroup.Build[Config](ctx, builderutil.Options[Config]{func(c *Config) error {
+		c.A = 1
+		return nil
+	}})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/zeroxsolutions/go-utils/builderutil/errgroup
+
+go 1.21
+
+require (
+	github.com/zeroxsolutions/go-utils v0.1.0
+	golang.org/x/sync v0.10.0
+)
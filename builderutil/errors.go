@@ -0,0 +1,88 @@
+package builderutil
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNilTarget is returned when a nil pointer is passed as the instance to configure.
+var ErrNilTarget = errors.New("builderutil: target must not be nil")
+
+// ErrNegativeCount is returned when a negative number of instances is requested.
+var ErrNegativeCount = errors.New("builderutil: count must not be negative")
+
+// BuildError reports which configuration function caused a build to fail.
+// ListerIndex is the position of the Lister in the options passed to the build function,
+// and FuncIndex is the position of the function within the slice returned by its List method.
+// Both indices count skipped nil entries, so they match the positions seen by the caller.
+type BuildError struct {
+	// ListerIndex is the index of the Lister that provided the failing function.
+	ListerIndex int
+	// FuncIndex is the index of the failing function within the Lister's List.
+	FuncIndex int
+	// Err is the error returned by the failing function.
+	Err error
+
+	// path is the field changed by the failing function, as recorded by BuildDebug.
+	path string
+}
+
+// Error returns a message including the lister and function indices and the underlying error,
+// and the field being set when it is known.
+func (e *BuildError) Error() string {
+
+	if e.path != "" {
+		return fmt.Sprintf("builderutil: option %d of lister %d failed while setting %s: %v", e.FuncIndex, e.ListerIndex, e.path, e.Err)
+	}
+
+	return fmt.Sprintf("builderutil: option %d of lister %d failed: %v", e.FuncIndex, e.ListerIndex, e.Err)
+}
+
+// Unwrap returns the underlying error so that errors.Is and errors.As can inspect it.
+func (e *BuildError) Unwrap() error {
+	return e.Err
+}
+
+// FieldPath returns the dotted path, such as "TLS.MinVersion", of the field the failing
+// function changed before returning its error. It is only determined by BuildDebug, and only
+// if the function changed exactly one field; otherwise it is empty.
+//
+// Returns:
+// - The path of the single changed field, or an empty string.
+func (e *BuildError) FieldPath() string {
+	return e.path
+}
+
+// ElementError reports which element of a multi-instance build failed.
+type ElementError struct {
+	// Index is the index of the element whose build failed.
+	Index int
+	// Err is the error returned while building the element.
+	Err error
+}
+
+// Error returns a message including the element index and the underlying error.
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("builderutil: element %d failed: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error so that errors.Is and errors.As can inspect it.
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError reports that a fully-configured instance failed validation.
+type ValidationError struct {
+	// Err is the error returned by the validation.
+	Err error
+}
+
+// Error returns a message describing the validation failure.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("builderutil: validation failed: %v", e.Err)
+}
+
+// Unwrap returns the underlying error so that errors.Is and errors.As can inspect it.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
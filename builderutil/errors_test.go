@@ -0,0 +1,57 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildError_Indices tests if Build reports the lister and function indices of the failing option.
+func TestBuildError_Indices(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	noop := func(*Config) error {
+		return nil
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	// The third function of the second lister fails
+	mockLister1 := &MockLister[Config]{Funcs: []func(*Config) error{noop}}
+	mockLister2 := &MockLister[Config]{Funcs: []func(*Config) error{noop, noop, errFunc}}
+
+	_, err := builderutil.Build[Config](mockLister1, mockLister2)
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) {
+		t.Fatalf("Expected *BuildError, got %v", err)
+	}
+
+	if buildErr.ListerIndex != 1 {
+		t.Errorf("Expected ListerIndex to be 1, got %d", buildErr.ListerIndex)
+	}
+	if buildErr.FuncIndex != 2 {
+		t.Errorf("Expected FuncIndex to be 2, got %d", buildErr.FuncIndex)
+	}
+
+	// Verify that the original error is still reachable
+	if !errors.Is(err, errFailed) {
+		t.Errorf("Expected error to match %v", errFailed)
+	}
+}
+
+// TestBuildError_Error tests if the error message includes the indices and the underlying error.
+func TestBuildError_Error(t *testing.T) {
+	err := &builderutil.BuildError{ListerIndex: 1, FuncIndex: 2, Err: errors.New("boom")}
+
+	expected := "builderutil: option 2 of lister 1 failed: boom"
+	if err.Error() != expected {
+		t.Errorf("Expected %q, got %q", expected, err.Error())
+	}
+}
@@ -0,0 +1,45 @@
+package builderutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNoListers is returned when a build requires at least one non-nil Lister and none was given.
+var ErrNoListers = errors.New("builderutil: no listers provided")
+
+// BuildFirst implements a fallback chain at the lister granularity: each Lister is applied to
+// a fresh instance of T in turn, and the first instance built without error is returned.
+// Later Listers are not applied once one succeeds. Nil Listers are skipped.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T], each providing an alternative configuration.
+//
+// Returns:
+// - A pointer to the instance built by the first successful Lister.
+// - ErrNoListers if opts holds no non-nil Lister, or the joined errors of every Lister if all fail.
+func BuildFirst[T any](opts ...Lister[T]) (*T, error) {
+
+	var errs []error
+
+	for i, opt := range opts {
+		if isNilLister(opt) {
+			continue
+		}
+
+		t := new(T)
+
+		if err := apply(context.Background(), t, []Lister[T]{opt}, nil); err != nil {
+			errs = append(errs, fmt.Errorf("builderutil: lister %d: %w", i, err))
+			continue
+		}
+
+		return t, nil
+	}
+
+	if len(errs) == 0 {
+		return nil, ErrNoListers
+	}
+
+	return nil, errors.Join(errs...)
+}
@@ -0,0 +1,85 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildFirst_Fallback tests if BuildFirst returns the result of the second lister when the first fails.
+func TestBuildFirst_Fallback(t *testing.T) {
+	type Config struct {
+		Source string
+	}
+
+	errUnavailable := errors.New("primary unavailable")
+
+	setSource := func(source string) func(*Config) error {
+		return func(c *Config) error {
+			c.Source = source
+			return nil
+		}
+	}
+	errFunc := func(*Config) error {
+		return errUnavailable
+	}
+
+	calls := 0
+	never := func(*Config) error {
+		calls++
+		return nil
+	}
+
+	primary := builderutil.Options[Config]{setSource("primary"), errFunc}
+	secondary := builderutil.Options[Config]{setSource("secondary")}
+	tertiary := builderutil.Options[Config]{never}
+
+	config, err := builderutil.BuildFirst[Config](primary, nil, secondary, tertiary)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Verify that the secondary result was built from a fresh instance and later listers were skipped
+	if config.Source != "secondary" {
+		t.Errorf("Expected config.Source to be secondary, got %s", config.Source)
+	}
+	if calls != 0 {
+		t.Errorf("Expected the tertiary lister not to run, got %d calls", calls)
+	}
+}
+
+// TestBuildFirst_AllFail tests if BuildFirst joins the errors of every lister on total failure.
+func TestBuildFirst_AllFail(t *testing.T) {
+	type Config struct {
+		Source string
+	}
+
+	errFirst := errors.New("first error")
+	errSecond := errors.New("second error")
+
+	failWith := func(err error) builderutil.Options[Config] {
+		return builderutil.Options[Config]{func(*Config) error { return err }}
+	}
+
+	config, err := builderutil.BuildFirst[Config](failWith(errFirst), failWith(errSecond))
+	if !errors.Is(err, errFirst) || !errors.Is(err, errSecond) {
+		t.Fatalf("Expected error to match both errors, got %v", err)
+	}
+
+	if config != nil {
+		t.Errorf("Expected config to be nil, got %v", config)
+	}
+}
+
+// TestBuildFirst_NoListers tests if BuildFirst reports ErrNoListers when nothing can be tried.
+func TestBuildFirst_NoListers(t *testing.T) {
+	type Config struct {
+		Source string
+	}
+
+	_, err := builderutil.BuildFirst[Config](nil)
+	if !errors.Is(err, builderutil.ErrNoListers) {
+		t.Fatalf("Expected ErrNoListers, got %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package builderutil
+
+import "fmt"
+
+// WithDefault returns a configuration function that sets a field to def only if the field
+// still holds its zero value. Option bundles can use it to provide sane defaults that users
+// override by placing their own options first.
+// Parameters:
+// - get: Reads the current value of the field.
+// - set: Writes a new value to the field.
+// - def: The default value to apply when the field is zero.
+//
+// Returns:
+// - A configuration function that applies the default when needed and never fails.
+func WithDefault[T any, V comparable](get func(*T) V, set func(*T, V), def V) func(*T) error {
+	return func(t *T) error {
+
+		var zero V
+		if get(t) == zero {
+			set(t, def)
+		}
+
+		return nil
+	}
+}
+
+// Append returns a configuration function that appends items to a slice field instead of
+// replacing it, so several option bundles can each contribute entries. The current slice is
+// clipped to its length first, so appending always allocates a new backing array when needed
+// instead of writing into spare capacity shared with a BuildFrom template or earlier builds.
+// Parameters:
+// - get: Reads the current value of the slice field.
+// - set: Writes the new slice to the field.
+// - items: Variadic items to append.
+//
+// Returns:
+// - A configuration function that appends the items and never fails.
+func Append[T any, E any](get func(*T) []E, set func(*T, []E), items ...E) func(*T) error {
+
+	items = append([]E(nil), items...)
+
+	return func(t *T) error {
+
+		s := get(t)
+		set(t, append(s[:len(s):len(s)], items...))
+
+		return nil
+	}
+}
+
+// PutMap returns a configuration function that inserts a single entry into a map field,
+// so several option bundles can each contribute entries. If the map is nil, a new map is
+// created and stored with set before inserting; otherwise the existing map is modified in
+// place. An existing entry for key is overwritten.
+// Parameters:
+// - get: Reads the current value of the map field.
+// - set: Writes a newly created map to the field.
+// - key: The key of the entry.
+// - val: The value of the entry.
+//
+// Returns:
+// - A configuration function that inserts the entry and never fails.
+func PutMap[T any, K comparable, V any](get func(*T) map[K]V, set func(*T, map[K]V), key K, val V) func(*T) error {
+	return func(t *T) error {
+
+		m := get(t)
+		if m == nil {
+			m = make(map[K]V)
+			set(t, m)
+		}
+
+		m[key] = val
+
+		return nil
+	}
+}
+
+// SetFormatted returns a configuration function that assigns fmt.Sprintf(format, args...) to
+// a string field, which is convenient for derived fields such as display names. The string is
+// formatted each time the option is applied, not when SetFormatted is called, so arguments
+// that are pointers or implement fmt.Stringer reflect their state at apply time.
+// Parameters:
+// - set: Writes the formatted string to the field.
+// - format: The fmt format string.
+// - args: Variadic arguments referenced by format.
+//
+// Returns:
+// - A configuration function that assigns the formatted string and never fails.
+func SetFormatted[T any](set func(*T, string), format string, args ...any) func(*T) error {
+
+	args = append([]any(nil), args...)
+
+	return func(t *T) error {
+
+		set(t, fmt.Sprintf(format, args...))
+
+		return nil
+	}
+}
+
+// FieldSetter produces type-safe options for the single field of T selected by its accessor.
+// It is created by Field.
+type FieldSetter[T any, V any] struct {
+	get func(*T) *V
+}
+
+// Field returns a FieldSetter for the field of T whose address get returns, for example:
+//
+//	builderutil.Field(func(c *Config) *int { return &c.Port }).To(8080)
+//
+// This gives closure-free, type-checked field setting without reflection.
+// Parameters:
+// - get: Returns the address of the field within the instance of T.
+//
+// Returns:
+// - A FieldSetter for the selected field.
+func Field[T any, V any](get func(*T) *V) FieldSetter[T, V] {
+	return FieldSetter[T, V]{get: get}
+}
+
+// To returns a configuration function that assigns val to the field.
+// Parameters:
+// - val: The value to assign.
+//
+// Returns:
+// - A configuration function that sets the field and never fails.
+func (f FieldSetter[T, V]) To(val V) func(*T) error {
+	return func(t *T) error {
+
+		*f.get(t) = val
+
+		return nil
+	}
+}
@@ -0,0 +1,202 @@
+package builderutil_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// fieldsConfig is a configuration type shared by the field helper tests.
+type fieldsConfig struct {
+	Port int
+}
+
+// TestWithDefault tests if WithDefault applies the default only when the field is zero.
+func TestWithDefault(t *testing.T) {
+	getPort := func(c *fieldsConfig) int { return c.Port }
+	setPort := func(c *fieldsConfig, port int) { c.Port = port }
+
+	defaultPort := builderutil.WithDefault(getPort, setPort, 8080)
+	userPort := func(c *fieldsConfig) error {
+		c.Port = 9090
+		return nil
+	}
+
+	// The default is applied when no option set the field
+	config, err := builderutil.Build[fieldsConfig](builderutil.Options[fieldsConfig]{defaultPort})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Port != 8080 {
+		t.Errorf("Expected config.Port to be 8080, got %d", config.Port)
+	}
+
+	// The default is skipped when an earlier option already set the field
+	config, err = builderutil.Build[fieldsConfig](builderutil.Options[fieldsConfig]{userPort, defaultPort})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Port != 9090 {
+		t.Errorf("Expected config.Port to be 9090, got %d", config.Port)
+	}
+}
+
+// TestAppend tests if appends from two separate options accumulate rather than overwrite.
+func TestAppend(t *testing.T) {
+	type Config struct {
+		Plugins []string
+	}
+
+	getPlugins := func(c *Config) []string { return c.Plugins }
+	setPlugins := func(c *Config, plugins []string) { c.Plugins = plugins }
+
+	config, err := builderutil.Build[Config](
+		builderutil.Options[Config]{builderutil.Append(getPlugins, setPlugins, "auth", "metrics")},
+		builderutil.Options[Config]{builderutil.Append(getPlugins, setPlugins, "tracing")},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"auth", "metrics", "tracing"}
+	if !reflect.DeepEqual(config.Plugins, expected) {
+		t.Errorf("Expected plugins %v, got %v", expected, config.Plugins)
+	}
+}
+
+// TestAppend_SharedCapacity tests if builds from a template with spare capacity do not overwrite each other.
+func TestAppend_SharedCapacity(t *testing.T) {
+	type Config struct {
+		Plugins []string
+	}
+
+	getPlugins := func(c *Config) []string { return c.Plugins }
+	setPlugins := func(c *Config, plugins []string) { c.Plugins = plugins }
+
+	template := Config{Plugins: make([]string, 1, 4)}
+
+	a, err := builderutil.BuildFrom(template, builderutil.Options[Config]{builderutil.Append(getPlugins, setPlugins, "a")})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	c, err := builderutil.BuildFrom(template, builderutil.Options[Config]{builderutil.Append(getPlugins, setPlugins, "c")})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(a.Plugins, []string{"", "a"}) || !reflect.DeepEqual(c.Plugins, []string{"", "c"}) {
+		t.Errorf("Expected independent plugins, got %q and %q", a.Plugins, c.Plugins)
+	}
+
+	if spare := template.Plugins[:2]; spare[1] != "" {
+		t.Errorf("Expected the template capacity to be untouched, got %q", spare)
+	}
+}
+
+// TestPutMap tests if PutMap inserts into nil and pre-populated maps and overwrites existing keys.
+func TestPutMap(t *testing.T) {
+	type Config struct {
+		Labels map[string]string
+	}
+
+	getLabels := func(c *Config) map[string]string { return c.Labels }
+	setLabels := func(c *Config, labels map[string]string) { c.Labels = labels }
+
+	// Insert into a nil map
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{
+		builderutil.PutMap(getLabels, setLabels, "env", "dev"),
+		builderutil.PutMap(getLabels, setLabels, "team", "core"),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := map[string]string{"env": "dev", "team": "core"}
+	if !reflect.DeepEqual(config.Labels, expected) {
+		t.Errorf("Expected labels %v, got %v", expected, config.Labels)
+	}
+
+	// Insert into a pre-populated map, overwriting an existing key
+	config = &Config{Labels: map[string]string{"env": "dev", "zone": "a"}}
+	err = builderutil.BuildInto[Config](config, builderutil.Options[Config]{builderutil.PutMap(getLabels, setLabels, "env", "prod")})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected = map[string]string{"env": "prod", "zone": "a"}
+	if !reflect.DeepEqual(config.Labels, expected) {
+		t.Errorf("Expected labels %v, got %v", expected, config.Labels)
+	}
+}
+
+// counterStringer is a fmt.Stringer reporting how many times it was formatted.
+type counterStringer struct {
+	calls int
+}
+
+// String increments and returns the number of calls.
+func (c *counterStringer) String() string {
+	c.calls++
+	return fmt.Sprint(c.calls)
+}
+
+// TestSetFormatted tests if SetFormatted assigns the formatted value computed at apply time.
+func TestSetFormatted(t *testing.T) {
+	type Config struct {
+		Name string
+	}
+
+	counter := &counterStringer{}
+	setName := func(c *Config, name string) { c.Name = name }
+
+	option := builderutil.SetFormatted(setName, "%s-%d #%v", "api", 3, counter)
+	if counter.calls != 0 {
+		t.Fatalf("Expected no formatting before apply, got %d calls", counter.calls)
+	}
+
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{option})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Name != "api-3 #1" {
+		t.Errorf("Expected config.Name to be 'api-3 #1', got '%s'", config.Name)
+	}
+
+	config, err = builderutil.Build[Config](builderutil.Options[Config]{option})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Name != "api-3 #2" {
+		t.Errorf("Expected config.Name to be 'api-3 #2', got '%s'", config.Name)
+	}
+}
+
+// TestField_To tests if the option produced by a FieldSetter sets the field and composes with Build.
+func TestField_To(t *testing.T) {
+	type Config struct {
+		Port int
+		Tags []string
+	}
+
+	port := builderutil.Field(func(c *Config) *int { return &c.Port })
+	tags := builderutil.Field(func(c *Config) *[]string { return &c.Tags })
+
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{
+		port.To(80),
+		tags.To([]string{"a"}),
+		port.To(8080),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Port != 8080 {
+		t.Errorf("Expected config.Port to be 8080, got %d", config.Port)
+	}
+	if !reflect.DeepEqual(config.Tags, []string{"a"}) {
+		t.Errorf("Expected config.Tags to be [a], got %v", config.Tags)
+	}
+}
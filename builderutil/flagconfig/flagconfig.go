@@ -0,0 +1,138 @@
+// Package flagconfig provides a builderutil Lister that populates configuration from
+// command-line flags declared with struct tags.
+package flagconfig
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// ErrUnsupportedType is returned when a tagged field has a type that cannot back a flag.
+var ErrUnsupportedType = errors.New("flagconfig: unsupported field type")
+
+// Register defines a flag on fs for every exported field of T tagged with
+// flag:"name,default,usage", and returns a Lister that applies the parsed values once
+// fs.Parse has been called, for example:
+//
+//	type Config struct {
+//		Addr string `flag:"addr,:8080,listen address"`
+//	}
+//
+// The default and usage parts are optional; the usage may contain commas. The Lister sets a
+// field when its flag was given on the command line or when it has a non-empty default, so
+// earlier options are only overridden by values the user or the tag actually provided.
+// Supported field types are string, bool, int, int64, uint, uint64, float64 and time.Duration.
+// Parameters:
+// - fs: The FlagSet on which the flags are defined.
+//
+// Returns:
+// - A Lister applying the parsed flags to the target.
+// - An error if a field cannot be registered, for example because its flag already exists.
+func Register[T any](fs *flag.FlagSet) (builderutil.Lister[T], error) {
+
+	staged := new(T)
+
+	rv := reflect.ValueOf(staged).Elem()
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("flagconfig: %s is not a struct", rv.Type())
+	}
+
+	type binding struct {
+		name       string
+		index      int
+		hasDefault bool
+	}
+
+	var bindings []binding
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+
+		tag, ok := sf.Tag.Lookup("flag")
+		if !ok || tag == "-" || !sf.IsExported() {
+			continue
+		}
+
+		parts := strings.SplitN(tag, ",", 3)
+		for len(parts) < 3 {
+			parts = append(parts, "")
+		}
+		name, def, usage := parts[0], parts[1], parts[2]
+
+		if name == "" {
+			return nil, fmt.Errorf("flagconfig: field %s has an empty flag name", sf.Name)
+		}
+		if fs.Lookup(name) != nil {
+			return nil, fmt.Errorf("flagconfig: flag %q for field %s is already defined", name, sf.Name)
+		}
+
+		if err := define(fs, rv.Field(i), name, usage); err != nil {
+			return nil, fmt.Errorf("flagconfig: field %s: %w", sf.Name, err)
+		}
+
+		if def != "" {
+			f := fs.Lookup(name)
+			if err := f.Value.Set(def); err != nil {
+				return nil, fmt.Errorf("flagconfig: invalid default %q for flag %q: %w", def, name, err)
+			}
+			f.DefValue = def
+		}
+
+		bindings = append(bindings, binding{name: name, index: i, hasDefault: def != ""})
+	}
+
+	return builderutil.Options[T]{func(t *T) error {
+
+		if !fs.Parsed() {
+			return fmt.Errorf("flagconfig: flags have not been parsed")
+		}
+
+		given := map[string]bool{}
+		fs.Visit(func(f *flag.Flag) {
+			given[f.Name] = true
+		})
+
+		dst := reflect.ValueOf(t).Elem()
+		for _, b := range bindings {
+			if given[b.name] || b.hasDefault {
+				dst.Field(b.index).Set(rv.Field(b.index))
+			}
+		}
+
+		return nil
+	}}, nil
+}
+
+// define registers a flag called name that stores its value into field.
+func define(fs *flag.FlagSet, field reflect.Value, name, usage string) error {
+
+	switch p := field.Addr().Interface().(type) {
+	case *string:
+		fs.StringVar(p, name, "", usage)
+	case *bool:
+		fs.BoolVar(p, name, false, usage)
+	case *int:
+		fs.IntVar(p, name, 0, usage)
+	case *int64:
+		fs.Int64Var(p, name, 0, usage)
+	case *uint:
+		fs.UintVar(p, name, 0, usage)
+	case *uint64:
+		fs.Uint64Var(p, name, 0, usage)
+	case *float64:
+		fs.Float64Var(p, name, 0, usage)
+	case *time.Duration:
+		fs.DurationVar(p, name, 0, usage)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, field.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package flagconfig_test
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+	"github.com/zeroxsolutions/go-utils/builderutil/flagconfig"
+)
+
+// Config is a configuration type populated from command-line flags.
+type Config struct {
+	Addr    string        `flag:"addr,:8080,listen address, host and port"`
+	Debug   bool          `flag:"debug,,enable debug logging"`
+	Workers int           `flag:"workers,4"`
+	Timeout time.Duration `flag:"timeout"`
+	Region  string        `flag:"region"`
+	Ignored string
+}
+
+// newFlagSet returns a FlagSet that does not print on errors.
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+// TestRegister tests if the lister sets fields from parsed flags and tag defaults.
+func TestRegister(t *testing.T) {
+	fs := newFlagSet()
+
+	lister, err := flagconfig.Register[Config](fs)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if err := fs.Parse([]string{"-debug", "-timeout", "5s", "-addr", "localhost:9090"}); err != nil {
+		t.Fatalf("Expected no parse error, got %v", err)
+	}
+
+	setRegion := func(c *Config) error {
+		c.Region = "eu-west-1"
+		return nil
+	}
+
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{setRegion}, lister)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Unset flags without a default, like region, keep the value of earlier options
+	expected := Config{Addr: "localhost:9090", Debug: true, Workers: 4, Timeout: 5 * time.Second, Region: "eu-west-1"}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+
+	if usage := fs.Lookup("addr").Usage; usage != "listen address, host and port" {
+		t.Errorf("Expected usage to keep commas, got %q", usage)
+	}
+	if def := fs.Lookup("workers").DefValue; def != "4" {
+		t.Errorf("Expected workers default to be 4, got %q", def)
+	}
+}
+
+// TestRegister_NotParsed tests if the lister fails when the FlagSet has not been parsed.
+func TestRegister_NotParsed(t *testing.T) {
+	lister, err := flagconfig.Register[Config](newFlagSet())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := builderutil.Build[Config](lister); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
+
+// TestRegister_Errors tests if Register reports unsupported types, invalid defaults and duplicate flags.
+func TestRegister_Errors(t *testing.T) {
+	type Unsupported struct {
+		Hosts []string `flag:"hosts"`
+	}
+	if _, err := flagconfig.Register[Unsupported](newFlagSet()); !errors.Is(err, flagconfig.ErrUnsupportedType) {
+		t.Errorf("Expected ErrUnsupportedType, got %v", err)
+	}
+
+	type InvalidDefault struct {
+		Workers int `flag:"workers,many"`
+	}
+	if _, err := flagconfig.Register[InvalidDefault](newFlagSet()); err == nil {
+		t.Error("Expected an error for an invalid default, got nil")
+	}
+
+	fs := newFlagSet()
+	fs.String("addr", "", "")
+	if _, err := flagconfig.Register[Config](fs); err == nil {
+		t.Error("Expected an error for a duplicate flag, got nil")
+	}
+}
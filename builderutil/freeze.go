@@ -0,0 +1,161 @@
+package builderutil
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// frozen holds the type and address of the instances returned by BuildFrozen. Keying by type
+// as well keeps a pointer to the first field of a frozen struct, which shares its address,
+// from being considered frozen. Entries are removed by a finalizer when their instance is
+// garbage collected, so an address is never reused while it is still registered. The count
+// mirrors the number of entries, which lets checkFrozen skip the lock and reflection entirely
+// while no instance is frozen.
+var frozen = struct {
+	sync.RWMutex
+	keys  map[pointerKey]struct{}
+	count atomic.Int64
+}{keys: make(map[pointerKey]struct{})}
+
+// FrozenError is returned when a package setter is asked to modify an instance returned by
+// BuildFrozen.
+type FrozenError struct {
+	// Type is the type of the frozen instance.
+	Type reflect.Type
+}
+
+// Error returns a message naming the type of the frozen instance.
+func (e *FrozenError) Error() string {
+	return fmt.Sprintf("builderutil: cannot modify frozen instance of %s", e.Type)
+}
+
+// BuildFrozen is like Build but marks the result as frozen, to catch accidental mutation by
+// downstream code in tests. Go cannot make a struct immutable, so freezing is enforced by this
+// package only: BuildInto, Apply and the reflective setters such as SetField, SetNestedField,
+// FromMap, CopyFrom, FromTag, FromEnv and FromKV refuse to modify a frozen instance with a
+// *FrozenError.
+// Plain assignments and closures that write fields directly are not prevented. A copy made by
+// dereferencing the pointer, and pointers to the fields of a frozen instance, are not frozen.
+//
+// While at least one frozen instance is alive, those entry points, including the hot-path
+// Apply, look up their target in a global registry under a read lock, which costs a few
+// reflective calls per invocation. Programs that never call BuildFrozen pay a single atomic
+// load instead.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed, frozen instance of T.
+// - A *BuildError for the failing function.
+func BuildFrozen[T any](opts ...Lister[T]) (*T, error) {
+
+	t, err := Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Zero-sized values share a single address, so they cannot be told apart; they have
+	// no fields to protect anyway.
+	if reflect.TypeOf(t).Elem().Size() == 0 {
+		return t, nil
+	}
+
+	key := frozenKey(t)
+
+	frozen.Lock()
+	if _, ok := frozen.keys[key]; !ok {
+		frozen.keys[key] = struct{}{}
+		frozen.count.Add(1)
+	}
+	frozen.Unlock()
+
+	runtime.SetFinalizer(t, func(*T) {
+		frozen.Lock()
+		if _, ok := frozen.keys[key]; ok {
+			delete(frozen.keys, key)
+			frozen.count.Add(-1)
+		}
+		frozen.Unlock()
+	})
+
+	return t, nil
+}
+
+// IsFrozen reports whether t was returned by BuildFrozen.
+// Parameters:
+// - t: The instance to check.
+//
+// Returns:
+// - True if package setters refuse to modify t.
+func IsFrozen[T any](t *T) bool {
+	return checkFrozen(t) != nil
+}
+
+// checkFrozen returns a *FrozenError if t was returned by BuildFrozen.
+func checkFrozen[T any](t *T) error {
+
+	if t == nil || frozen.count.Load() == 0 {
+		return nil
+	}
+
+	frozen.RLock()
+	_, ok := frozen.keys[frozenKey(t)]
+	frozen.RUnlock()
+
+	if ok {
+		return &FrozenError{Type: reflect.TypeOf(t).Elem()}
+	}
+
+	return nil
+}
+
+// frozenKey returns the registry key of t.
+func frozenKey[T any](t *T) pointerKey {
+	return pointerKey{typ: reflect.TypeOf(t), addr: reflect.ValueOf(t).Pointer()}
+}
+
+// ReadOnly is a read-only view of a built instance of T, suited to configuration shared
+// across goroutines after construction. It exposes no mutators, and Get hands out copies,
+// so callers cannot change the state seen by other readers. Go cannot enforce immutability
+// structurally; returning copies is the mechanism, and it documents the intent. The zero
+// value is a view of the zero value of T. A ReadOnly is safe for concurrent use.
+type ReadOnly[T any] struct {
+	v *T
+}
+
+// Get returns a deep copy of the instance: pointers, slices, maps, arrays, interfaces and
+// exported struct fields are copied recursively, so mutating the result never affects the
+// view. Channels, functions and unexported fields are shared.
+//
+// Returns:
+// - An independent copy of the instance.
+func (r ReadOnly[T]) Get() T {
+
+	if r.v == nil {
+		var zero T
+		return zero
+	}
+
+	return *deepClone(r.v)
+}
+
+// BuildReadOnly is like Build but returns the instance as a ReadOnly view, which keeps
+// downstream code from mutating it after construction.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A read-only view of the newly constructed instance of T.
+// - A *BuildError for the failing function.
+func BuildReadOnly[T any](opts ...Lister[T]) (ReadOnly[T], error) {
+
+	t, err := Build(opts...)
+	if err != nil {
+		return ReadOnly[T]{}, err
+	}
+
+	return ReadOnly[T]{v: t}, nil
+}
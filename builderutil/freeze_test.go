@@ -0,0 +1,160 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// freezeConfig is a configuration type used by the freeze tests.
+type freezeConfig struct {
+	Name string
+	Port int
+}
+
+// TestBuildFrozen tests if package setters refuse to modify a frozen instance.
+func TestBuildFrozen(t *testing.T) {
+	setName := func(c *freezeConfig) error {
+		c.Name = "api"
+		return nil
+	}
+
+	config, err := builderutil.BuildFrozen[freezeConfig](builderutil.Options[freezeConfig]{setName})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !builderutil.IsFrozen(config) {
+		t.Fatalf("Expected the instance to be frozen")
+	}
+
+	setters := map[string]error{
+		"SetField":       builderutil.SetField[freezeConfig]("Port", 8080)(config),
+		"SetNestedField": builderutil.SetNestedField[freezeConfig]("Port", 8080)(config),
+		"FromMap":        builderutil.FromMap[freezeConfig](map[string]any{"Port": 1}).List()[0](config),
+		"CopyFrom":       builderutil.CopyFrom[freezeConfig](freezeConfig{Port: 8080})(config),
+		"Apply":          builderutil.Apply(config, setName),
+		"BuildInto":      builderutil.BuildInto[freezeConfig](config, builderutil.Options[freezeConfig]{setName}),
+	}
+
+	for name, err := range setters {
+		var frozenErr *builderutil.FrozenError
+		if !errors.As(err, &frozenErr) {
+			t.Errorf("Expected %s to return *FrozenError, got %v", name, err)
+		}
+	}
+
+	if config.Port != 0 || config.Name != "api" {
+		t.Errorf("Expected the frozen instance to be unchanged, got %+v", *config)
+	}
+}
+
+// TestBuildFrozen_FieldPointer tests if a pointer to the first field of a frozen instance is not frozen.
+func TestBuildFrozen_FieldPointer(t *testing.T) {
+	config, err := builderutil.BuildFrozen[freezeConfig]()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if builderutil.IsFrozen(&config.Name) {
+		t.Errorf("Expected the first field not to be frozen")
+	}
+
+	setName := func(name *string) error {
+		*name = "api"
+		return nil
+	}
+
+	if err := builderutil.Apply(&config.Name, setName); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !builderutil.IsFrozen(config) {
+		t.Errorf("Expected the instance to stay frozen")
+	}
+}
+
+// TestBuildFrozen_Copy tests if copies of a frozen instance and regular builds are not frozen.
+func TestBuildFrozen_Copy(t *testing.T) {
+	config, err := builderutil.BuildFrozen[freezeConfig]()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	copied := *config
+	if err := builderutil.Apply(&copied, builderutil.SetField[freezeConfig]("Port", 8080)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if copied.Port != 8080 {
+		t.Errorf("Expected copied.Port to be 8080, got %d", copied.Port)
+	}
+
+	built, err := builderutil.Build[freezeConfig]()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if builderutil.IsFrozen(built) {
+		t.Errorf("Expected a regular build not to be frozen")
+	}
+}
+
+// TestBuildFrozen_Error tests if BuildFrozen returns the error of a failing option.
+func TestBuildFrozen_Error(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	errFunc := func(*freezeConfig) error {
+		return errFailed
+	}
+
+	_, err := builderutil.BuildFrozen[freezeConfig](builderutil.Options[freezeConfig]{errFunc})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+}
+
+// TestBuildReadOnly tests if Get returns an independent copy on each call.
+func TestBuildReadOnly(t *testing.T) {
+	type Config struct {
+		Name   string
+		Tags   []string
+		Limits map[string]int
+	}
+
+	view, err := builderutil.BuildReadOnly[Config](builderutil.Options[Config]{func(c *Config) error {
+		c.Name = "api"
+		c.Tags = []string{"a"}
+		c.Limits = map[string]int{"rps": 100}
+		return nil
+	}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	first := view.Get()
+	first.Name = "changed"
+	first.Tags[0] = "changed"
+	first.Limits["rps"] = 1
+
+	second := view.Get()
+	if second.Name != "api" || second.Tags[0] != "a" || second.Limits["rps"] != 100 {
+		t.Errorf("Expected an unchanged copy, got %+v", second)
+	}
+
+	var zero builderutil.ReadOnly[Config]
+	if got := zero.Get(); got.Name != "" || got.Tags != nil {
+		t.Errorf("Expected the zero view to return a zero value, got %+v", got)
+	}
+}
+
+// TestBuildReadOnly_Error tests if BuildReadOnly returns the error of a failing option.
+func TestBuildReadOnly_Error(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	_, err := builderutil.BuildReadOnly[freezeConfig](builderutil.Options[freezeConfig]{func(*freezeConfig) error {
+		return errFailed
+	}})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+}
@@ -0,0 +1,111 @@
+package builderutil
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrDependencyCycle is returned by BuildGraph when Listers depend on each other in a cycle.
+var ErrDependencyCycle = errors.New("builderutil: dependency cycle")
+
+// ErrMissingDependency is returned by BuildGraph when a Lister depends on an unknown name.
+var ErrMissingDependency = errors.New("builderutil: missing dependency")
+
+// ErrDuplicateName is returned by BuildGraph when several Listers have the same name.
+var ErrDuplicateName = errors.New("builderutil: duplicate lister name")
+
+// DependentLister is a Lister that declares which other Listers must run before it, so
+// ordering can be expressed declaratively instead of by slice position, for example a
+// "configure pool" Lister depending on "set pool size".
+type DependentLister[T any] interface {
+	Lister[T]
+	// Name returns the unique name other Listers use to depend on this one.
+	Name() string
+	// DependsOn returns the names of the Listers that must be applied before this one.
+	DependsOn() []string
+}
+
+// BuildGraph is like Build but first sorts the Listers topologically by their dependencies,
+// so every Lister runs after all the Listers it depends on. The order is deterministic: among
+// the Listers whose dependencies are satisfied, the one passed first runs first, so Listers
+// unrelated by dependencies keep their relative order. Nil Listers are ignored. The graph is
+// checked before any option runs, and a *BuildError reports the position of the failing
+// Lister in opts, not in the sorted order.
+// Parameters:
+// - opts: Variadic arguments of type DependentLister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - An error wrapping ErrDuplicateName, ErrMissingDependency or ErrDependencyCycle, or a *BuildError.
+func BuildGraph[T any](opts ...DependentLister[T]) (*T, error) {
+
+	listers := make([]Lister[T], len(opts))
+	index := make(map[string]int, len(opts))
+
+	for i, opt := range opts {
+		if isNilLister[T](opt) {
+			continue
+		}
+
+		listers[i] = opt
+
+		name := opt.Name()
+		if _, ok := index[name]; ok {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateName, name)
+		}
+		index[name] = i
+	}
+
+	// pending[i] counts the unapplied dependencies of opts[i]
+	pending := make([]int, len(opts))
+	dependents := make([][]int, len(opts))
+
+	for i, l := range listers {
+		if l == nil {
+			continue
+		}
+
+		for _, dep := range opts[i].DependsOn() {
+			j, ok := index[dep]
+			if !ok {
+				return nil, fmt.Errorf("%w: %q required by %q", ErrMissingDependency, dep, opts[i].Name())
+			}
+
+			pending[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+
+	perm := make([]int, 0, len(index))
+	done := make([]bool, len(opts))
+
+	for len(perm) < len(index) {
+		next := -1
+		for i, l := range listers {
+			if l != nil && !done[i] && pending[i] == 0 {
+				next = i
+				break
+			}
+		}
+
+		if next < 0 {
+			var cycle []string
+			for i, l := range listers {
+				if l != nil && !done[i] {
+					cycle = append(cycle, opts[i].Name())
+				}
+			}
+			return nil, fmt.Errorf("%w among %s", ErrDependencyCycle, strings.Join(cycle, ", "))
+		}
+
+		done[next] = true
+		perm = append(perm, next)
+
+		for _, i := range dependents[next] {
+			pending[i]--
+		}
+	}
+
+	return applyPermuted(listers, perm)
+}
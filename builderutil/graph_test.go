@@ -0,0 +1,99 @@
+package builderutil_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// graphConfig is a configuration type recording the order in which listers ran.
+type graphConfig struct {
+	Steps []string
+}
+
+// dependent is a DependentLister recording its name when applied.
+type dependent struct {
+	name string
+	deps []string
+	err  error
+}
+
+// List returns a single function appending the name to the recorded steps.
+func (d dependent) List() []func(*graphConfig) error {
+	return []func(*graphConfig) error{func(c *graphConfig) error {
+		c.Steps = append(c.Steps, d.name)
+		return d.err
+	}}
+}
+
+// Name returns the name of the lister.
+func (d dependent) Name() string {
+	return d.name
+}
+
+// DependsOn returns the names of the listers that must run first.
+func (d dependent) DependsOn() []string {
+	return d.deps
+}
+
+// TestBuildGraph tests if listers are applied after their dependencies, in otherwise stable order.
+func TestBuildGraph(t *testing.T) {
+	config, err := builderutil.BuildGraph[graphConfig](
+		dependent{name: "pool", deps: []string{"size", "timeout"}},
+		dependent{name: "logging"},
+		dependent{name: "timeout", deps: []string{"size"}},
+		nil,
+		dependent{name: "size"},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"logging", "size", "timeout", "pool"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+}
+
+// TestBuildGraph_Cycle tests if a dependency cycle is detected.
+func TestBuildGraph_Cycle(t *testing.T) {
+	_, err := builderutil.BuildGraph[graphConfig](
+		dependent{name: "logging"},
+		dependent{name: "a", deps: []string{"c"}},
+		dependent{name: "b", deps: []string{"a"}},
+		dependent{name: "c", deps: []string{"b"}},
+	)
+	if !errors.Is(err, builderutil.ErrDependencyCycle) {
+		t.Fatalf("Expected ErrDependencyCycle, got %v", err)
+	}
+}
+
+// TestBuildGraph_Invalid tests if missing dependencies and duplicate names are rejected.
+func TestBuildGraph_Invalid(t *testing.T) {
+	_, err := builderutil.BuildGraph[graphConfig](dependent{name: "pool", deps: []string{"size"}})
+	if !errors.Is(err, builderutil.ErrMissingDependency) {
+		t.Errorf("Expected ErrMissingDependency, got %v", err)
+	}
+
+	_, err = builderutil.BuildGraph[graphConfig](dependent{name: "pool"}, dependent{name: "pool"})
+	if !errors.Is(err, builderutil.ErrDuplicateName) {
+		t.Errorf("Expected ErrDuplicateName, got %v", err)
+	}
+}
+
+// TestBuildGraph_ErrorIndex tests if the failing lister is reported at its position in the arguments.
+func TestBuildGraph_ErrorIndex(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	_, err := builderutil.BuildGraph[graphConfig](
+		dependent{name: "pool", deps: []string{"size"}, err: errFailed},
+		dependent{name: "size"},
+	)
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) || buildErr.ListerIndex != 0 || !errors.Is(err, errFailed) {
+		t.Fatalf("Expected *BuildError at lister 0 wrapping %v, got %v", errFailed, err)
+	}
+}
@@ -0,0 +1,62 @@
+package builderutil
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownGroup is returned when rebuilding a group name that no option belongs to.
+var ErrUnknownGroup = errors.New("builderutil: unknown group")
+
+// group is a Lister that tags a set of Listers with a section name.
+type group[T any] struct {
+	name string
+	opts chain[T]
+}
+
+// List flattens the functions of every non-nil member Lister, preserving their order.
+func (g group[T]) List() []func(*T) error {
+	return g.opts.List()
+}
+
+// Group returns a Lister that combines several Listers under a section name, such as
+// "logging" or "database". Passed to Build, it behaves exactly like Chain; added to a Builder,
+// it can also be re-applied on its own with BuildGroup, which suits live-reload scenarios
+// where only one section of the configuration changed.
+// Parameters:
+// - name: The section name used to select the group.
+// - opts: Variadic Listers belonging to the group, in application order.
+//
+// Returns:
+// - A Lister whose List method returns the functions of all members in order.
+func Group[T any](name string, opts ...Lister[T]) Lister[T] {
+	return group[T]{name: name, opts: append(chain[T](nil), opts...)}
+}
+
+// BuildGroup applies only the options of the groups named name onto an existing target, in
+// the order they were added, leaving every field set by other options untouched. Only groups
+// added directly to the Builder are considered, not groups nested inside other Listers.
+// Parameters:
+// - name: The section name passed to Group.
+// - target: A pointer to the instance of T to reconfigure. It must not be nil.
+//
+// Returns:
+// - ErrNilTarget if target is nil.
+// - An error wrapping ErrUnknownGroup if no group has that name.
+// - A *BuildError for the failing function, indexed within the selected groups.
+func (b *Builder[T]) BuildGroup(name string, target *T) error {
+
+	var opts []Lister[T]
+
+	for _, opt := range b.opts {
+		if g, ok := opt.(group[T]); ok && g.name == name {
+			opts = append(opts, g)
+		}
+	}
+
+	if len(opts) == 0 {
+		return fmt.Errorf("%w: %q", ErrUnknownGroup, name)
+	}
+
+	return BuildInto(target, opts...)
+}
@@ -0,0 +1,81 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// groupConfig is a configuration type with fields owned by different groups.
+type groupConfig struct {
+	Level string
+	Host  string
+}
+
+// TestGroup tests if a group applies all of its members when passed to Build.
+func TestGroup(t *testing.T) {
+	logging := builderutil.Group[groupConfig]("logging", builderutil.Options[groupConfig]{func(c *groupConfig) error {
+		c.Level = "debug"
+		return nil
+	}}, nil)
+
+	config, err := builderutil.Build(logging)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Level != "debug" {
+		t.Errorf("Expected Level to be 'debug', got '%s'", config.Level)
+	}
+}
+
+// TestBuilder_BuildGroup tests if only the named group is re-applied onto the target.
+func TestBuilder_BuildGroup(t *testing.T) {
+	level := "info"
+	host := "localhost"
+
+	b := builderutil.NewBuilder(
+		builderutil.Group[groupConfig]("logging", builderutil.Options[groupConfig]{func(c *groupConfig) error {
+			c.Level = level
+			return nil
+		}}),
+		builderutil.Group[groupConfig]("database", builderutil.Options[groupConfig]{func(c *groupConfig) error {
+			c.Host = host
+			return nil
+		}}),
+	)
+
+	config, err := b.Build()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	level = "debug"
+	host = "db.example.com"
+
+	if err := b.BuildGroup("logging", config); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Level != "debug" {
+		t.Errorf("Expected Level to be 'debug', got '%s'", config.Level)
+	}
+
+	if config.Host != "localhost" {
+		t.Errorf("Expected Host to be untouched, got '%s'", config.Host)
+	}
+}
+
+// TestBuilder_BuildGroup_Unknown tests if an unknown group name returns ErrUnknownGroup.
+func TestBuilder_BuildGroup_Unknown(t *testing.T) {
+	b := builderutil.NewBuilder(builderutil.Group[groupConfig]("logging"))
+
+	if err := b.BuildGroup("metrics", &groupConfig{}); !errors.Is(err, builderutil.ErrUnknownGroup) {
+		t.Errorf("Expected ErrUnknownGroup, got %v", err)
+	}
+
+	if err := b.BuildGroup("logging", nil); !errors.Is(err, builderutil.ErrNilTarget) {
+		t.Errorf("Expected ErrNilTarget, got %v", err)
+	}
+}
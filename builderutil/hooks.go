@@ -0,0 +1,47 @@
+package builderutil
+
+import "context"
+
+// Hooks holds optional callbacks invoked around each configuration function during a build.
+// They give visibility into the build process, for example to log timing or count applied
+// options, without modifying the option functions themselves. Nil callbacks are skipped.
+type Hooks[T any] struct {
+	// Before is called right before the function at funcIdx of the Lister at listerIdx runs.
+	Before func(listerIdx, funcIdx int)
+	// After is called right after the function ran, with the error it returned.
+	After func(listerIdx, funcIdx int, err error)
+}
+
+// BuildWithHooks is like Build but calls the hooks in h around each configuration function.
+// Nil options and nil functions are skipped without triggering any hook.
+// Parameters:
+// - h: The hooks to call around each configuration function.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError for the failing function.
+func BuildWithHooks[T any](h Hooks[T], opts ...Lister[T]) (*T, error) {
+
+	t := new(T)
+
+	err := apply(context.Background(), t, opts, func(i, j int, fn func(*T) error) error {
+
+		if h.Before != nil {
+			h.Before(i, j)
+		}
+
+		err := fn(t)
+
+		if h.After != nil {
+			h.After(i, j, err)
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
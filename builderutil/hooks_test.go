@@ -0,0 +1,76 @@
+package builderutil_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildWithHooks tests if both hooks fire with the correct indices and After receives the error.
+func TestBuildWithHooks(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	noop := func(*Config) error {
+		return nil
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	var events []string
+	hooks := builderutil.Hooks[Config]{
+		Before: func(listerIdx, funcIdx int) {
+			events = append(events, fmt.Sprintf("before %d/%d", listerIdx, funcIdx))
+		},
+		After: func(listerIdx, funcIdx int, err error) {
+			events = append(events, fmt.Sprintf("after %d/%d: %v", listerIdx, funcIdx, err))
+		},
+	}
+
+	mockLister1 := &MockLister[Config]{Funcs: []func(*Config) error{noop, nil}}
+	mockLister2 := &MockLister[Config]{Funcs: []func(*Config) error{errFunc, noop}}
+
+	_, err := builderutil.BuildWithHooks[Config](hooks, mockLister1, mockLister2)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	// Verify the hooks fired only for functions that ran, with the returned error
+	expected := []string{
+		"before 0/0",
+		"after 0/0: <nil>",
+		"before 1/0",
+		"after 1/0: error in function",
+	}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("Expected events %v, got %v", expected, events)
+	}
+}
+
+// TestBuildWithHooks_NilCallbacks tests if BuildWithHooks works with empty hooks.
+func TestBuildWithHooks_NilCallbacks(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	setValue := func(c *Config) error {
+		c.Value = 42
+		return nil
+	}
+
+	config, err := builderutil.BuildWithHooks[Config](builderutil.Hooks[Config]{}, &MockLister[Config]{Funcs: []func(*Config) error{setValue}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
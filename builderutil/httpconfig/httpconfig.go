@@ -0,0 +1,39 @@
+// Package httpconfig provides a builderutil Lister that populates configuration from HTTP
+// headers declared with struct tags, for per-request configuration.
+package httpconfig
+
+import (
+	"net/http"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// FromHeaders returns a Lister that sets every exported field of T tagged with header:"Name"
+// from the matching header of h, for example:
+//
+//	type RequestConfig struct {
+//		Tenant  string        `header:"X-Tenant"`
+//		Timeout time.Duration `header:"X-Timeout"`
+//	}
+//
+// Header names are matched case-insensitively through their canonical form, as http.Header.Get
+// does, and only the first value of a header is used. Fields whose header is absent are left
+// untouched, so earlier options act as defaults. Values are parsed like builderutil.FromTag
+// does, and frozen targets are refused. The header map is read when the Lister is applied, not
+// when FromHeaders is called.
+// Parameters:
+// - h: The headers to read, typically the headers of an incoming *http.Request.
+//
+// Returns:
+// - A Lister whose single function fails with the field and header name on a parse error.
+func FromHeaders[T any](h http.Header) builderutil.Lister[T] {
+	return builderutil.FromTag[T]("header", "header", func(name string) (string, bool, error) {
+
+		values := h.Values(name)
+		if len(values) == 0 {
+			return "", false, nil
+		}
+
+		return values[0], true, nil
+	})
+}
@@ -0,0 +1,98 @@
+package httpconfig_test
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+	"github.com/zeroxsolutions/go-utils/builderutil/httpconfig"
+)
+
+// requestConfig is a configuration type populated from HTTP headers.
+type requestConfig struct {
+	Tenant  string        `header:"X-Tenant"`
+	Debug   bool          `header:"x-debug"`
+	Limit   int           `header:"X-Limit"`
+	Retries uint8         `header:"X-Retries"`
+	Ratio   float64       `header:"X-Ratio"`
+	Timeout time.Duration `header:"X-Timeout"`
+	Region  string        `header:"X-Region"`
+	Ignored string
+}
+
+// TestFromHeaders tests if tagged fields are parsed from the headers and missing headers are skipped.
+func TestFromHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("X-Tenant", "acme")
+	h.Set("X-Debug", "true")
+	h.Set("X-Limit", "-5")
+	h.Set("X-Retries", "3")
+	h.Set("X-Ratio", "0.25")
+	h.Set("X-Timeout", "1500ms")
+	h.Set("Ignored", "value")
+
+	config, err := builderutil.Build[requestConfig](builderutil.Options[requestConfig]{
+		func(c *requestConfig) error {
+			c.Region = "eu"
+			return nil
+		},
+	}, httpconfig.FromHeaders[requestConfig](h))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := requestConfig{
+		Tenant:  "acme",
+		Debug:   true,
+		Limit:   -5,
+		Retries: 3,
+		Ratio:   0.25,
+		Timeout: 1500 * time.Millisecond,
+		Region:  "eu",
+	}
+	if *config != want {
+		t.Errorf("Expected %+v, got %+v", want, *config)
+	}
+}
+
+// TestFromHeaders_Errors tests if invalid values, unsupported types and frozen targets are reported.
+func TestFromHeaders_Errors(t *testing.T) {
+	h := http.Header{}
+	h.Set("X-Retries", "300")
+
+	_, err := builderutil.Build[requestConfig](httpconfig.FromHeaders[requestConfig](h))
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Fatalf("Expected strconv.ErrRange, got %v", err)
+	}
+
+	type unsupported struct {
+		Tags []string `header:"X-Tags"`
+	}
+
+	h.Set("X-Tags", "a")
+
+	_, err = builderutil.Build[unsupported](httpconfig.FromHeaders[unsupported](h))
+	if !errors.Is(err, builderutil.ErrUnsupportedKind) {
+		t.Fatalf("Expected ErrUnsupportedKind, got %v", err)
+	}
+
+	frozen, err := builderutil.BuildFrozen[requestConfig]()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	h = http.Header{}
+	h.Set("X-Tenant", "acme")
+
+	var frozenErr *builderutil.FrozenError
+	if err := httpconfig.FromHeaders[requestConfig](h).List()[0](frozen); !errors.As(err, &frozenErr) {
+		t.Errorf("Expected *FrozenError, got %v", err)
+	}
+
+	if frozen.Tenant != "" {
+		t.Errorf("Expected the frozen instance to be unchanged, got %+v", *frozen)
+	}
+}
@@ -0,0 +1,47 @@
+// Package httperr maps builderutil errors to HTTP status codes, so services that build
+// request-scoped configuration respond consistently across endpoints.
+package httperr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// StatusCode returns the HTTP status code appropriate for err. Wrapping errors such as
+// *builderutil.BuildError and *builderutil.ElementError are unwrapped to inspect the cause:
+//
+//   - nil maps to 200 OK;
+//   - *builderutil.PanicError and builderutil.ErrNilTarget map to 500 Internal Server Error,
+//     since they reveal a bug in the server rather than in the request;
+//   - builderutil.ErrOptionTimeout and context.DeadlineExceeded map to 504 Gateway Timeout;
+//   - *builderutil.ValidationError maps to 400 Bad Request;
+//   - anything else maps to 500 Internal Server Error.
+//
+// The cases are checked in this order, so a panic takes precedence over any other cause in
+// the same chain.
+// Parameters:
+// - err: The error returned by a build.
+//
+// Returns:
+// - The HTTP status code for err.
+func StatusCode(err error) int {
+
+	var panicErr *builderutil.PanicError
+	var validationErr *builderutil.ValidationError
+
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.As(err, &panicErr), errors.Is(err, builderutil.ErrNilTarget):
+		return http.StatusInternalServerError
+	case errors.Is(err, builderutil.ErrOptionTimeout), errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.As(err, &validationErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
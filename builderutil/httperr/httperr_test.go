@@ -0,0 +1,59 @@
+package httperr_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+	"github.com/zeroxsolutions/go-utils/builderutil/httperr"
+)
+
+// Config is a configuration type built by the tests.
+type Config struct {
+	Host string `validate:"required"`
+}
+
+// TestStatusCode tests if each builder error type maps to its expected status.
+func TestStatusCode(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	panicking := builderutil.Options[Config]{func(*Config) error {
+		panic("boom")
+	}}
+	slow := builderutil.Options[Config]{func(*Config) error {
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	}}
+	failing := builderutil.Options[Config]{func(*Config) error {
+		return errFailed
+	}}
+
+	_, panicErr := builderutil.BuildSafe[Config](panicking)
+	_, timeoutErr := builderutil.BuildWithTimeout[Config](time.Millisecond, slow)
+	_, validationErr := builderutil.Build[Config](builderutil.Options[Config]{builderutil.ValidateTags[Config]()})
+	_, otherErr := builderutil.Build[Config](failing)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "nil", err: nil, expected: http.StatusOK},
+		{name: "nil target", err: builderutil.BuildInto[Config](nil), expected: http.StatusInternalServerError},
+		{name: "panic", err: panicErr, expected: http.StatusInternalServerError},
+		{name: "timeout", err: timeoutErr, expected: http.StatusGatewayTimeout},
+		{name: "deadline", err: context.DeadlineExceeded, expected: http.StatusGatewayTimeout},
+		{name: "validation", err: validationErr, expected: http.StatusBadRequest},
+		{name: "element", err: &builderutil.ElementError{Index: 1, Err: validationErr}, expected: http.StatusBadRequest},
+		{name: "other", err: otherErr, expected: http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		if status := httperr.StatusCode(test.err); status != test.expected {
+			t.Errorf("Expected status %d for %s, got %d (%v)", test.expected, test.name, status, test.err)
+		}
+	}
+}
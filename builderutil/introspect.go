@@ -0,0 +1,33 @@
+package builderutil
+
+// NamedLister is a Lister that also names each of its functions, so tooling can render a
+// build plan or generate documentation. Names()[i] describes List()[i]; both slices are
+// expected to have the same length. Build ignores the names.
+type NamedLister[T any] interface {
+	Lister[T]
+	// Names returns one name per function returned by List, in the same order.
+	Names() []string
+}
+
+// Count returns the number of functions l contributes to a build, that is the number of
+// non-nil functions in its List. A nil Lister contributes none.
+// Parameters:
+// - l: The Lister to inspect.
+//
+// Returns:
+// - The number of non-nil functions returned by l.List.
+func Count[T any](l Lister[T]) int {
+
+	if isNilLister(l) {
+		return 0
+	}
+
+	n := 0
+	for _, fn := range l.List() {
+		if fn != nil {
+			n++
+		}
+	}
+
+	return n
+}
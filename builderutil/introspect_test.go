@@ -0,0 +1,86 @@
+package builderutil_test
+
+import (
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// namedOptions is a NamedLister pairing every function with a name.
+type namedOptions[T any] struct {
+	names []string
+	funcs []func(*T) error
+}
+
+// List returns the held functions.
+func (n *namedOptions[T]) List() []func(*T) error {
+	return n.funcs
+}
+
+// Names returns the name of each held function.
+func (n *namedOptions[T]) Names() []string {
+	return n.names
+}
+
+// TestCount tests if Count excludes nil functions and handles nil listers.
+func TestCount(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	noop := func(*Config) error {
+		return nil
+	}
+
+	if n := builderutil.Count[Config](builderutil.Options[Config]{noop, nil, noop}); n != 2 {
+		t.Errorf("Expected 2, got %d", n)
+	}
+	if n := builderutil.Count[Config](nil); n != 0 {
+		t.Errorf("Expected 0 for a nil lister, got %d", n)
+	}
+}
+
+// TestNamedLister tests if names align with functions and Build ignores them.
+func TestNamedLister(t *testing.T) {
+	type Config struct {
+		Host string
+		Port int
+	}
+
+	lister := &namedOptions[Config]{
+		names: []string{"host", "port"},
+		funcs: []func(*Config) error{
+			func(c *Config) error { c.Host = "localhost"; return nil },
+			func(c *Config) error { c.Port = 8080; return nil },
+		},
+	}
+
+	var l builderutil.Lister[Config] = lister
+
+	named, ok := l.(builderutil.NamedLister[Config])
+	if !ok {
+		t.Fatal("Expected lister to implement NamedLister")
+	}
+	if len(named.Names()) != builderutil.Count(l) {
+		t.Errorf("Expected %d names, got %d", builderutil.Count(l), len(named.Names()))
+	}
+
+	// Apply the functions one by one to check each name describes its function
+	for i, name := range named.Names() {
+		config := &Config{}
+		if err := builderutil.Apply(config, named.List()[i]); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if name == "host" && config.Host != "localhost" || name == "port" && config.Port != 8080 {
+			t.Errorf("Expected function %d to set %s, got %+v", i, name, *config)
+		}
+	}
+
+	config, err := builderutil.Build(l)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Host != "localhost" || config.Port != 8080 {
+		t.Errorf("Expected {localhost 8080}, got %+v", *config)
+	}
+}
@@ -0,0 +1,33 @@
+package builderutil
+
+// KVGetter is the minimal read interface of a key-value store such as Consul or etcd. Keeping
+// it this small lets any client be adapted without this package depending on it.
+type KVGetter interface {
+	// Get returns the value stored under key and whether it exists, or an error if the
+	// store could not be read.
+	Get(key string) (string, bool, error)
+}
+
+// FromKV returns a Lister that populates the exported fields of T tagged with kv:"name" from
+// the key prefix+name of kv, for example:
+//
+//	type Config struct {
+//		Addr string `kv:"addr"`
+//	}
+//
+//	builderutil.FromKV[Config](store, "services/api/")
+//
+// Keys are read when the option is applied, and missing keys leave their field untouched.
+// Values are parsed into the field type; supported types are string, bool, integer, float and
+// time.Duration.
+// Parameters:
+// - kv: The store to read from. It must not be nil.
+// - prefix: The prefix prepended to every tag name to form the key.
+//
+// Returns:
+// - A Lister whose single function fails with the field and key name on a read or parse error.
+func FromKV[T any](kv KVGetter, prefix string) Lister[T] {
+	return FromTag[T]("kv", "key", func(name string) (string, bool, error) {
+		return kv.Get(prefix + name)
+	})
+}
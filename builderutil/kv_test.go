@@ -0,0 +1,85 @@
+package builderutil_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// kvConfig is a configuration type populated from a key-value store.
+type kvConfig struct {
+	Addr    string        `kv:"addr"`
+	Workers int           `kv:"workers"`
+	Timeout time.Duration `kv:"timeout"`
+	Region  string        `kv:"region"`
+}
+
+// fakeKV is a KVGetter backed by a map.
+type fakeKV struct {
+	values map[string]string
+	err    error
+}
+
+// Get returns the value stored under key, or the configured error.
+func (f fakeKV) Get(key string) (string, bool, error) {
+
+	if f.err != nil {
+		return "", false, f.err
+	}
+
+	value, ok := f.values[key]
+
+	return value, ok, nil
+}
+
+// TestFromKV tests if FromKV populates fields from prefixed keys and skips missing ones.
+func TestFromKV(t *testing.T) {
+	kv := fakeKV{values: map[string]string{
+		"services/api/addr":    ":8080",
+		"services/api/workers": "4",
+		"services/api/timeout": "5s",
+		"addr":                 "unprefixed",
+	}}
+
+	setRegion := func(c *kvConfig) error {
+		c.Region = "eu-west-1"
+		return nil
+	}
+
+	config, err := builderutil.Build[kvConfig](builderutil.Options[kvConfig]{setRegion}, builderutil.FromKV[kvConfig](kv, "services/api/"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := kvConfig{Addr: ":8080", Workers: 4, Timeout: 5 * time.Second, Region: "eu-west-1"}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+}
+
+// TestFromKV_ParseError tests if FromKV reports the field on a parse error.
+func TestFromKV_ParseError(t *testing.T) {
+	kv := fakeKV{values: map[string]string{"workers": "many"}}
+
+	_, err := builderutil.Build[kvConfig](builderutil.FromKV[kvConfig](kv, ""))
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "Workers") || !strings.Contains(err.Error(), "workers") {
+		t.Errorf("Expected error to name the field and key, got %v", err)
+	}
+}
+
+// TestFromKV_ReadError tests if FromKV surfaces store errors.
+func TestFromKV_ReadError(t *testing.T) {
+	errUnavailable := errors.New("store unavailable")
+
+	_, err := builderutil.Build[kvConfig](builderutil.FromKV[kvConfig](fakeKV{err: errUnavailable}, ""))
+	if !errors.Is(err, errUnavailable) {
+		t.Fatalf("Expected %v, got %v", errUnavailable, err)
+	}
+}
@@ -0,0 +1,47 @@
+package builderutil
+
+import (
+	"context"
+	"log/slog"
+)
+
+// BuildWithLogger is like Build but logs the build progress through logger. A debug record
+// is emitted before each configuration function runs, and an error record is emitted when a
+// function fails. Both records carry the lister and function indices as attributes.
+// A nil logger falls back to slog.Default, so logging follows the process-wide configuration.
+// Parameters:
+// - logger: The logger used to record build progress, or nil to use slog.Default.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError for the failing function.
+func BuildWithLogger[T any](logger *slog.Logger, opts ...Lister[T]) (*T, error) {
+
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	ctx := context.Background()
+
+	hooks := Hooks[T]{
+		Before: func(listerIdx, funcIdx int) {
+			logger.LogAttrs(ctx, slog.LevelDebug, "builderutil: applying option",
+				slog.Int("lister_index", listerIdx),
+				slog.Int("func_index", funcIdx),
+			)
+		},
+		After: func(listerIdx, funcIdx int, err error) {
+			if err == nil {
+				return
+			}
+			logger.LogAttrs(ctx, slog.LevelError, "builderutil: option failed",
+				slog.Int("lister_index", listerIdx),
+				slog.Int("func_index", funcIdx),
+				slog.Any("error", err),
+			)
+		},
+	}
+
+	return BuildWithHooks(hooks, opts...)
+}
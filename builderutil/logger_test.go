@@ -0,0 +1,108 @@
+package builderutil_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// captureHandler is a slog.Handler that records every log record for inspection.
+type captureHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *captureHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r)
+	return nil
+}
+
+func (h *captureHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+
+func (h *captureHandler) WithGroup(string) slog.Handler { return h }
+
+// attrs returns the attributes of a record keyed by name.
+func attrs(r slog.Record) map[string]slog.Value {
+	m := map[string]slog.Value{}
+	r.Attrs(func(a slog.Attr) bool {
+		m[a.Key] = a.Value
+		return true
+	})
+	return m
+}
+
+// TestBuildWithLogger tests if BuildWithLogger logs each option at debug level and failures at error level.
+func TestBuildWithLogger(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	noop := func(*Config) error {
+		return nil
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	handler := &captureHandler{}
+	logger := slog.New(handler)
+
+	_, err := builderutil.BuildWithLogger[Config](logger, &MockLister[Config]{Funcs: []func(*Config) error{noop, errFunc}})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if len(handler.records) != 3 {
+		t.Fatalf("Expected 3 log records, got %d", len(handler.records))
+	}
+
+	// Verify the debug records for both options and the error record for the failing one
+	for i, level := range []slog.Level{slog.LevelDebug, slog.LevelDebug, slog.LevelError} {
+		if handler.records[i].Level != level {
+			t.Errorf("Expected record %d level to be %v, got %v", i, level, handler.records[i].Level)
+		}
+	}
+
+	failed := attrs(handler.records[2])
+	if failed["lister_index"].Int64() != 0 || failed["func_index"].Int64() != 1 {
+		t.Errorf("Expected indices 0/1 on the error record, got %v/%v", failed["lister_index"], failed["func_index"])
+	}
+	if failed["error"].Any() != errFailed {
+		t.Errorf("Expected error attribute to be %v, got %v", errFailed, failed["error"])
+	}
+}
+
+// TestBuildWithLogger_NilLogger tests if BuildWithLogger falls back to the default logger.
+func TestBuildWithLogger_NilLogger(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	handler := &captureHandler{}
+	previous := slog.Default()
+	slog.SetDefault(slog.New(handler))
+	defer slog.SetDefault(previous)
+
+	noop := func(*Config) error {
+		return nil
+	}
+
+	_, err := builderutil.BuildWithLogger[Config](nil, &MockLister[Config]{Funcs: []func(*Config) error{noop}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(handler.records) != 1 {
+		t.Errorf("Expected 1 log record on the default logger, got %d", len(handler.records))
+	}
+}
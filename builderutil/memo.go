@@ -0,0 +1,58 @@
+package builderutil
+
+import "sync"
+
+// MemoBuilder caches built instances of T by a caller-supplied key, trading memory for build
+// time when the same expensive option set is used repeatedly. The zero value is an empty
+// MemoBuilder ready to use, and its methods are safe for concurrent use.
+//
+// Because callers may mutate the instances they receive, the cache never hands out the
+// instance it stores. The result of the first build is deep-copied into the cache, and every
+// call returns a fresh deep copy of the cached instance: pointers, slices, maps, arrays,
+// interfaces and exported struct fields are copied recursively. Channels, functions and
+// unexported fields cannot be copied this way and are shared between all returned instances.
+type MemoBuilder[T any] struct {
+	mu    sync.Mutex
+	cache map[string]*T
+}
+
+// Build returns a copy of the instance cached under key, or builds one from opts like Build
+// and caches it if there is none. The key must identify the option set: options passed with
+// a key that is already cached are not applied at all. Failed builds are not cached.
+// Concurrent calls for the same missing key may each build, the first result being kept.
+// Parameters:
+// - key: The fingerprint identifying the option set.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to a copy of the cached instance of T, owned by the caller.
+// - A *BuildError for the failing function.
+func (m *MemoBuilder[T]) Build(key string, opts ...Lister[T]) (*T, error) {
+
+	m.mu.Lock()
+	cached, ok := m.cache[key]
+	m.mu.Unlock()
+
+	if ok {
+		return deepClone(cached), nil
+	}
+
+	t, err := Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if cached, ok := m.cache[key]; ok {
+		return deepClone(cached), nil
+	}
+
+	if m.cache == nil {
+		m.cache = make(map[string]*T)
+	}
+	m.cache[key] = deepClone(t)
+
+	return t, nil
+}
@@ -0,0 +1,133 @@
+package builderutil_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// memoConfig is a configuration type with reference fields used by the MemoBuilder tests.
+type memoConfig struct {
+	Name   string
+	Tags   []string
+	Labels map[string]string
+	Parent *memoConfig
+}
+
+// TestMemoBuilder tests if a cached key skips re-applying options.
+func TestMemoBuilder(t *testing.T) {
+	var calls int
+
+	counting := builderutil.Options[memoConfig]{func(c *memoConfig) error {
+		calls++
+		c.Name = "api"
+		return nil
+	}}
+
+	var m builderutil.MemoBuilder[memoConfig]
+
+	for i := 0; i < 3; i++ {
+		config, err := m.Build("api", counting)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if config.Name != "api" {
+			t.Errorf("Expected config.Name to be api, got %s", config.Name)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected options to be applied once, got %d", calls)
+	}
+
+	if _, err := m.Build("other", counting); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected a new key to apply options again, got %d calls", calls)
+	}
+}
+
+// TestMemoBuilder_Clone tests if mutating a returned instance does not affect the cache.
+func TestMemoBuilder_Clone(t *testing.T) {
+	setAll := builderutil.Options[memoConfig]{func(c *memoConfig) error {
+		c.Tags = []string{"a"}
+		c.Labels = map[string]string{"env": "prod"}
+		c.Parent = &memoConfig{Name: "root"}
+		c.Parent.Parent = c.Parent
+		return nil
+	}}
+
+	var m builderutil.MemoBuilder[memoConfig]
+
+	first, err := m.Build("key", setAll)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	first.Tags[0] = "changed"
+	first.Labels["env"] = "changed"
+	first.Parent.Name = "changed"
+
+	second, err := m.Build("key", setAll)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if second.Tags[0] != "a" || second.Labels["env"] != "prod" || second.Parent.Name != "root" {
+		t.Errorf("Expected the cached instance to be isolated, got %+v", second)
+	}
+	if second.Parent.Parent != second.Parent {
+		t.Errorf("Expected the cycle to be preserved in the copy")
+	}
+}
+
+// TestMemoBuilder_Error tests if failed builds are not cached.
+func TestMemoBuilder_Error(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	fail := true
+	flaky := builderutil.Options[memoConfig]{func(*memoConfig) error {
+		if fail {
+			return errFailed
+		}
+		return nil
+	}}
+
+	var m builderutil.MemoBuilder[memoConfig]
+
+	if _, err := m.Build("key", flaky); !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	fail = false
+	if _, err := m.Build("key", flaky); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+// TestMemoBuilder_Concurrent tests if MemoBuilder is safe for concurrent use.
+func TestMemoBuilder_Concurrent(t *testing.T) {
+	var m builderutil.MemoBuilder[memoConfig]
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			config, err := m.Build("key", builderutil.Options[memoConfig]{func(c *memoConfig) error {
+				c.Tags = []string{"a"}
+				return nil
+			}})
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+				return
+			}
+			config.Tags[0] = "b"
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,126 @@
+package builderutil
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Merge returns a new instance of T holding base with every non-zero exported field of
+// override copied on top of it, which is handy for layering defaults, file configuration
+// and command-line flags. Zero-valued override fields leave the base value intact, so a
+// field cannot be reset to its zero value through override. The comparison is shallow:
+// a non-zero nested struct, slice or map replaces the base value as a whole.
+// Neither input is mutated. A nil base or override is treated as a zero value; if T is not
+// a struct, override wins whenever it is non-zero.
+// Parameters:
+// - base: The instance providing the initial values.
+// - override: The instance whose non-zero fields take precedence.
+//
+// Returns:
+// - A pointer to the merged instance of T.
+func Merge[T any](base, override *T) *T {
+
+	t := new(T)
+	if base != nil {
+		*t = *base
+	}
+
+	if override == nil {
+		return t
+	}
+
+	dst := reflect.ValueOf(t).Elem()
+	src := reflect.ValueOf(override).Elem()
+
+	if dst.Kind() != reflect.Struct {
+		if !src.IsZero() {
+			dst.Set(src)
+		}
+		return t
+	}
+
+	for i := 0; i < dst.NumField(); i++ {
+		if !dst.Type().Field(i).IsExported() {
+			continue
+		}
+
+		if field := src.Field(i); !field.IsZero() {
+			dst.Field(i).Set(field)
+		}
+	}
+
+	return t
+}
+
+// Inherit returns a Lister that copies every non-zero exported field of parent onto the
+// target, following the rules of Merge, so that a child configuration starts from its parent's
+// values. Zero fields of parent are skipped, so values the target already holds, such as
+// defaults set by earlier options, are not clobbered, and options placed after Inherit can
+// override inherited values. parent is read when the option is applied; a nil parent inherits
+// nothing.
+// Parameters:
+// - parent: The already-built instance to inherit from.
+//
+// Returns:
+// - A Lister whose single function merges parent onto the target.
+func Inherit[T any](parent *T) Lister[T] {
+	return Options[T]{func(t *T) error {
+
+		if err := checkFrozen(t); err != nil {
+			return err
+		}
+
+		*t = *Merge(t, parent)
+
+		return nil
+	}}
+}
+
+// CopyFrom returns a configuration function that copies the exported fields of src into the
+// fields of T with the same name, which removes manual field-by-field assignment when a config
+// is filled from a DTO or an API request. A field is copied only if the target has an exported
+// field of that name whose type the source type is assignable to; every other source field,
+// including unexported ones, is skipped silently. src may be a struct or a pointer to one, and
+// a nil pointer copies nothing. The copy is shallow, like an assignment.
+// Parameters:
+// - src: The struct value providing the fields to copy.
+//
+// Returns:
+// - A configuration function that returns ErrNotStruct if T or S is not a struct.
+func CopyFrom[T any, S any](src S) func(*T) error {
+	return func(t *T) error {
+
+		dst, err := settableStruct(t)
+		if err != nil {
+			return err
+		}
+
+		sv := reflect.ValueOf(&src).Elem()
+		if sv.Kind() == reflect.Pointer {
+			if sv.IsNil() {
+				return nil
+			}
+			sv = sv.Elem()
+		}
+
+		if sv.Kind() != reflect.Struct {
+			return fmt.Errorf("%w: %s", ErrNotStruct, sv.Type())
+		}
+
+		for i := 0; i < sv.NumField(); i++ {
+			sf := sv.Type().Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+
+			field, err := exportedField(dst, sf.Name)
+			if err != nil || !sf.Type.AssignableTo(field.Type()) {
+				continue
+			}
+
+			field.Set(sv.Field(i))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,162 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// mergeConfig is a configuration type used by the Merge tests.
+type mergeConfig struct {
+	Host  string
+	Port  int
+	Debug bool
+}
+
+// TestMerge tests if Merge overrides scalar fields without clobbering base with zero values.
+func TestMerge(t *testing.T) {
+	base := &mergeConfig{Host: "localhost", Port: 80, Debug: true}
+	override := &mergeConfig{Port: 8080}
+
+	merged := builderutil.Merge(base, override)
+
+	// Verify that only the non-zero override field took precedence
+	expected := mergeConfig{Host: "localhost", Port: 8080, Debug: true}
+	if *merged != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *merged)
+	}
+
+	// Verify that the inputs were not mutated
+	if *base != (mergeConfig{Host: "localhost", Port: 80, Debug: true}) {
+		t.Errorf("Expected base to be unchanged, got %+v", *base)
+	}
+	if *override != (mergeConfig{Port: 8080}) {
+		t.Errorf("Expected override to be unchanged, got %+v", *override)
+	}
+	if merged == base || merged == override {
+		t.Error("Expected Merge to return a new instance")
+	}
+}
+
+// TestMerge_Nil tests if Merge treats nil inputs as zero values.
+func TestMerge_Nil(t *testing.T) {
+	base := &mergeConfig{Host: "localhost"}
+
+	if merged := builderutil.Merge(base, nil); *merged != *base {
+		t.Errorf("Expected %+v, got %+v", *base, *merged)
+	}
+	if merged := builderutil.Merge(nil, base); *merged != *base {
+		t.Errorf("Expected %+v, got %+v", *base, *merged)
+	}
+}
+
+// TestMerge_NonStruct tests if Merge lets a non-zero override win for non-struct types.
+func TestMerge_NonStruct(t *testing.T) {
+	base, override, zero := 1, 2, 0
+
+	if merged := builderutil.Merge(&base, &override); *merged != 2 {
+		t.Errorf("Expected 2, got %d", *merged)
+	}
+	if merged := builderutil.Merge(&base, &zero); *merged != 1 {
+		t.Errorf("Expected 1, got %d", *merged)
+	}
+}
+
+// TestInherit tests if non-zero parent fields are inherited and later options override them.
+func TestInherit(t *testing.T) {
+	parent := &mergeConfig{Host: "parent.example.com", Port: 80}
+
+	setDebug := func(c *mergeConfig) error {
+		c.Debug = true
+		return nil
+	}
+	setPortDefault := func(c *mergeConfig) error {
+		c.Port = 9000
+		return nil
+	}
+	setHost := func(c *mergeConfig) error {
+		c.Host = "child.example.com"
+		return nil
+	}
+
+	config, err := builderutil.Build(
+		builderutil.Options[mergeConfig]{setDebug, setPortDefault},
+		builderutil.Inherit(parent),
+		builderutil.Options[mergeConfig]{setHost},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Debug is zero in the parent, so the child's own value survives
+	expected := mergeConfig{Host: "child.example.com", Port: 80, Debug: true}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+
+	orphan, err := builderutil.Build(builderutil.Options[mergeConfig]{setDebug}, builderutil.Inherit[mergeConfig](nil))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if *orphan != (mergeConfig{Debug: true}) {
+		t.Errorf("Expected a nil parent to inherit nothing, got %+v", *orphan)
+	}
+}
+
+// copySource is a DTO type used by the CopyFrom tests.
+type copySource struct {
+	Host    string
+	Port    string
+	Debug   bool
+	Extra   int
+	private string
+}
+
+// copyTarget is a configuration type used by the CopyFrom tests.
+type copyTarget struct {
+	Host    string
+	Port    int
+	Debug   bool
+	private string
+}
+
+// TestCopyFrom tests if CopyFrom copies matching fields and skips incompatible and unexported ones.
+func TestCopyFrom(t *testing.T) {
+	src := copySource{Host: "localhost", Port: "8080", Debug: true, Extra: 1, private: "secret"}
+
+	config, err := builderutil.Build[copyTarget](builderutil.Options[copyTarget]{
+		builderutil.CopyFrom[copyTarget](src),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := copyTarget{Host: "localhost", Debug: true}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+}
+
+// TestCopyFrom_Pointer tests if CopyFrom accepts pointer sources and ignores nil ones.
+func TestCopyFrom_Pointer(t *testing.T) {
+	config := &copyTarget{Host: "example.com"}
+
+	if err := builderutil.Apply(config, builderutil.CopyFrom[copyTarget, *copySource](nil)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Host != "example.com" {
+		t.Errorf("Expected Host to be untouched, got '%s'", config.Host)
+	}
+
+	if err := builderutil.Apply(config, builderutil.CopyFrom[copyTarget](&copySource{Host: "localhost"})); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Host != "localhost" {
+		t.Errorf("Expected Host to be 'localhost', got '%s'", config.Host)
+	}
+
+	if err := builderutil.Apply(config, builderutil.CopyFrom[copyTarget](42)); !errors.Is(err, builderutil.ErrNotStruct) {
+		t.Errorf("Expected ErrNotStruct, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+// Package metrics exposes builderutil build activity through expvar, so it is available
+// on /debug/vars without any additional dependency.
+package metrics
+
+import (
+	"expvar"
+	"sync"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// DefaultPrefix is the prefix of the counter names used when Init has not been called.
+const DefaultPrefix = "builderutil"
+
+// counters groups the expvar counters updated by Build.
+type counters struct {
+	builds  *expvar.Int
+	options *expvar.Int
+	errors  *expvar.Int
+}
+
+var (
+	mu      sync.Mutex
+	current *counters
+)
+
+// Init selects the prefix of the published counters, which are named prefix+".builds",
+// prefix+".options" and prefix+".errors". It can be called several times, even with the
+// same prefix; counters already published under a name are reused.
+// Parameters:
+// - prefix: The prefix of the counter names.
+func Init(prefix string) {
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	current = newCounters(prefix)
+}
+
+// newCounters returns the counters published under prefix.
+func newCounters(prefix string) *counters {
+	return &counters{
+		builds:  publish(prefix + ".builds"),
+		options: publish(prefix + ".options"),
+		errors:  publish(prefix + ".errors"),
+	}
+}
+
+// publish returns the expvar.Int called name, creating it if needed.
+func publish(name string) *expvar.Int {
+
+	if v, ok := expvar.Get(name).(*expvar.Int); ok {
+		return v
+	}
+
+	return expvar.NewInt(name)
+}
+
+// get returns the active counters, initializing them with DefaultPrefix if needed.
+func get() *counters {
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if current == nil {
+		current = newCounters(DefaultPrefix)
+	}
+
+	return current
+}
+
+// Build is like builderutil.Build but records its activity in expvar counters: the number of
+// builds, the number of option functions applied successfully, and the number of failed builds.
+// Parameters:
+// - opts: Variadic arguments of type builderutil.Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *builderutil.BuildError for the failing function.
+func Build[T any](opts ...builderutil.Lister[T]) (*T, error) {
+
+	c := get()
+
+	hooks := builderutil.Hooks[T]{
+		After: func(_, _ int, err error) {
+			if err == nil {
+				c.options.Add(1)
+			}
+		},
+	}
+
+	t, err := builderutil.BuildWithHooks(hooks, opts...)
+
+	c.builds.Add(1)
+	if err != nil {
+		c.errors.Add(1)
+	}
+
+	return t, err
+}
@@ -0,0 +1,63 @@
+package metrics_test
+
+import (
+	"errors"
+	"expvar"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+	"github.com/zeroxsolutions/go-utils/builderutil/metrics"
+)
+
+// value returns the current value of the expvar.Int called name, or 0 if it does not exist.
+func value(name string) int64 {
+	if v, ok := expvar.Get(name).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+// TestBuild tests if Build increments the build, option and error counters.
+func TestBuild(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	metrics.Init("metrics_test")
+
+	noop := func(*Config) error {
+		return nil
+	}
+	errFunc := func(*Config) error {
+		return errors.New("error in function")
+	}
+
+	builds, options, errs := value("metrics_test.builds"), value("metrics_test.options"), value("metrics_test.errors")
+
+	if _, err := metrics.Build[Config](builderutil.Options[Config]{noop, nil, noop}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := metrics.Build[Config](builderutil.Options[Config]{noop, errFunc, noop}); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if d := value("metrics_test.builds") - builds; d != 2 {
+		t.Errorf("Expected builds to increase by 2, got %d", d)
+	}
+	if d := value("metrics_test.options") - options; d != 3 {
+		t.Errorf("Expected options to increase by 3, got %d", d)
+	}
+	if d := value("metrics_test.errors") - errs; d != 1 {
+		t.Errorf("Expected errors to increase by 1, got %d", d)
+	}
+}
+
+// TestInit_Twice tests if calling Init again with the same prefix reuses the published counters.
+func TestInit_Twice(t *testing.T) {
+	metrics.Init("metrics_twice")
+	metrics.Init("metrics_twice")
+
+	if expvar.Get("metrics_twice.builds") == nil {
+		t.Fatal("Expected metrics_twice.builds to be published")
+	}
+}
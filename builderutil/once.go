@@ -0,0 +1,68 @@
+package builderutil
+
+import "sync"
+
+// Once returns a function that builds an instance of T from opts on its first call and
+// returns the cached result, including a cached error, on every later call. Construction is
+// guarded by sync.Once, so it happens exactly once even under concurrent access, which makes
+// it a good fit for package-level singletons such as global configuration.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A function returning the singleton instance of T and the error of its build.
+func Once[T any](opts ...Lister[T]) func() (*T, error) {
+
+	opts = append([]Lister[T](nil), opts...)
+
+	var (
+		once sync.Once
+		t    *T
+		err  error
+	)
+
+	return func() (*T, error) {
+
+		once.Do(func() {
+			t, err = Build(opts...)
+		})
+
+		return t, err
+	}
+}
+
+// CacheOnce returns a configuration function that computes a value with fn on its first
+// application and passes the cached value to apply on every application, so expensive work
+// whose result is identical across builds, such as reading a certificate from disk, runs
+// once however many builds use the option. Like Once, the computation is guarded by
+// sync.Once and its error is cached too: if fn fails, every application returns that error
+// without calling apply.
+// Parameters:
+// - fn: Computes the shared value.
+// - apply: Stores the shared value into the instance of T being built.
+//
+// Returns:
+// - A configuration function calling apply with the cached value, or returning the cached error.
+func CacheOnce[T any](fn func() (any, error), apply func(*T, any)) func(*T) error {
+
+	var (
+		once  sync.Once
+		value any
+		err   error
+	)
+
+	return func(t *T) error {
+
+		once.Do(func() {
+			value, err = fn()
+		})
+
+		if err != nil {
+			return err
+		}
+
+		apply(t, value)
+
+		return nil
+	}
+}
@@ -0,0 +1,149 @@
+package builderutil_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestOnce tests if the returned function builds only once under concurrent access; run it with -race.
+func TestOnce(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	var builds atomic.Int32
+	setValue := func(c *Config) error {
+		builds.Add(1)
+		c.Value = 42
+		return nil
+	}
+
+	get := builderutil.Once[Config](builderutil.Options[Config]{setValue})
+
+	results := make([]*Config, 50)
+
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			config, err := get()
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			results[i] = config
+		}(i)
+	}
+	wg.Wait()
+
+	if n := builds.Load(); n != 1 {
+		t.Errorf("Expected a single build, got %d", n)
+	}
+
+	// Every caller receives the same instance
+	for i, config := range results {
+		if config != results[0] {
+			t.Errorf("Expected result %d to be the cached instance", i)
+		}
+	}
+	if results[0].Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", results[0].Value)
+	}
+}
+
+// TestOnce_CachedError tests if a build error is cached and returned on every call.
+func TestOnce_CachedError(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	calls := 0
+	errFunc := func(*Config) error {
+		calls++
+		return errFailed
+	}
+
+	get := builderutil.Once[Config](builderutil.Options[Config]{errFunc})
+
+	for i := 0; i < 3; i++ {
+		if _, err := get(); !errors.Is(err, errFailed) {
+			t.Fatalf("Expected %v, got %v", errFailed, err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+}
+
+// TestCacheOnce tests if the value is computed once across many builds and applied on every build.
+func TestCacheOnce(t *testing.T) {
+	type Config struct {
+		Cert string
+	}
+
+	var computes, applies int32
+	option := builderutil.CacheOnce(func() (any, error) {
+		atomic.AddInt32(&computes, 1)
+		return "certificate", nil
+	}, func(c *Config, v any) {
+		atomic.AddInt32(&applies, 1)
+		c.Cert = v.(string)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			config, err := builderutil.Build[Config](builderutil.Options[Config]{option})
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+				return
+			}
+			if config.Cert != "certificate" {
+				t.Errorf("Expected config.Cert to be 'certificate', got '%s'", config.Cert)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if computes != 1 {
+		t.Errorf("Expected the value to be computed once, got %d", computes)
+	}
+	if applies != 20 {
+		t.Errorf("Expected apply to run 20 times, got %d", applies)
+	}
+}
+
+// TestCacheOnce_Error tests if a failed computation is cached and apply is never called.
+func TestCacheOnce_Error(t *testing.T) {
+	type Config struct{}
+
+	errFailed := errors.New("error in computation")
+
+	var computes, applies int
+	option := builderutil.CacheOnce(func() (any, error) {
+		computes++
+		return nil, errFailed
+	}, func(*Config, any) {
+		applies++
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, err := builderutil.Build[Config](builderutil.Options[Config]{option}); !errors.Is(err, errFailed) {
+			t.Fatalf("Expected %v, got %v", errFailed, err)
+		}
+	}
+
+	if computes != 1 || applies != 0 {
+		t.Errorf("Expected 1 computation and no apply, got %d and %d", computes, applies)
+	}
+}
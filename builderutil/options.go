@@ -0,0 +1,161 @@
+package builderutil
+
+import "sync"
+
+// Options is a ready-made Lister implementation backed by a plain slice of
+// configuration functions. It removes the need to declare a custom type just to
+// pass a handful of functions to Build.
+//
+// Example:
+//
+//	cfg, err := builderutil.Build(builderutil.Options[Config]{setA, setB})
+type Options[T any] []func(*T) error
+
+// List returns the underlying slice of configuration functions unchanged.
+// Nil entries are kept as-is and skipped by Build, exactly as for any other Lister.
+func (o Options[T]) List() []func(*T) error {
+	return o
+}
+
+// FromFuncs returns a Lister wrapping the supplied configuration functions. It is handy
+// when the functions are produced programmatically rather than written as a slice literal.
+// The functions are copied, so later changes to the caller's slice do not affect the Lister,
+// and nil entries are skipped by Build like for any other Lister.
+// Parameters:
+// - fns: Variadic configuration functions to wrap.
+//
+// Returns:
+// - A Lister whose List method returns the supplied functions in order.
+func FromFuncs[T any](fns ...func(*T) error) Lister[T] {
+	return append(Options[T](nil), fns...)
+}
+
+// Noop returns a configuration function that does nothing and never fails. It is a readable
+// placeholder in conditional code, where it is preferable to an inline empty closure.
+//
+// Returns:
+// - A configuration function that returns nil without touching the instance of T.
+func Noop[T any]() func(*T) error {
+	return func(*T) error { return nil }
+}
+
+// NoopLister returns a Lister that contributes no functions, the Lister counterpart of Noop.
+// Build skips it like a nil Lister.
+//
+// Returns:
+// - A Lister whose List method returns no functions.
+func NoopLister[T any]() Lister[T] {
+	return Options[T](nil)
+}
+
+// FromOptions returns a Lister wrapping options written in the common func(*T) idiom, so
+// existing option-based APIs can be fed to Build unchanged. Each option is adapted with
+// Recover and never fails; nil options are kept as nil functions and skipped by Build.
+// Parameters:
+// - opts: Variadic options of shape func(*T).
+//
+// Returns:
+// - A Lister whose List method returns the adapted options in order.
+func FromOptions[T any](opts ...func(*T)) Lister[T] {
+
+	fns := make(Options[T], len(opts))
+
+	for i, opt := range opts {
+		if opt != nil {
+			fns[i] = Recover(opt)
+		}
+	}
+
+	return fns
+}
+
+// AsOptions is the inverse of FromOptions: it adapts the functions of l into the common
+// func(*T) idiom, so a Lister can be passed to APIs accepting ...func(*T). The functions of
+// l are expected to be infallible. They are adapted with Must, so an adapted option panics
+// when its function returns an error, rather than silently dropping the error. Nil functions
+// are dropped, and a nil l yields no options.
+// Parameters:
+// - l: The Lister whose functions to adapt.
+//
+// Returns:
+// - The adapted options in order, each panicking if its function fails.
+func AsOptions[T any](l Lister[T]) []func(*T) {
+
+	if isNilLister(l) {
+		return nil
+	}
+
+	var opts []func(*T)
+
+	for _, fn := range l.List() {
+		if fn != nil {
+			opts = append(opts, Must(fn))
+		}
+	}
+
+	return opts
+}
+
+// chain is a Lister that concatenates the functions of several child Listers.
+type chain[T any] []Lister[T]
+
+// List flattens the functions of every non-nil child Lister, preserving their order.
+func (c chain[T]) List() []func(*T) error {
+
+	var fns []func(*T) error
+
+	for _, l := range c {
+		if isNilLister(l) {
+			continue
+		}
+
+		fns = append(fns, l.List()...)
+	}
+
+	return fns
+}
+
+// Chain returns a Lister that combines several Listers into a single reusable unit.
+// Applying the result is equivalent to passing the children to Build one after another.
+// Flattening is lazy: the children's List methods are called every time the returned
+// Lister's List method is called, not when Chain is called. Nil children are ignored.
+// Parameters:
+// - listers: Variadic Listers to concatenate, in application order.
+//
+// Returns:
+// - A Lister whose List method returns the functions of all children in order.
+func Chain[T any](listers ...Lister[T]) Lister[T] {
+	return append(chain[T](nil), listers...)
+}
+
+// lazy is a Lister that computes its functions on first use and caches them.
+type lazy[T any] struct {
+	once sync.Once
+	fn   func() []func(*T) error
+	fns  []func(*T) error
+}
+
+// List computes the functions on the first call and returns the cached result afterwards.
+func (l *lazy[T]) List() []func(*T) error {
+
+	l.once.Do(func() {
+		if l.fn != nil {
+			l.fns = l.fn()
+		}
+	})
+
+	return l.fns
+}
+
+// Lazy returns a Lister whose functions are computed by fn only when List is first called,
+// typically during Build, which avoids expensive work for Listers that end up unused.
+// The result is memoized, so fn runs at most once even across multiple builds, and it is
+// safe to use the Lister from concurrent builds.
+// Parameters:
+// - fn: Computes the configuration functions.
+//
+// Returns:
+// - A Lister deferring and caching the call to fn.
+func Lazy[T any](fn func() []func(*T) error) Lister[T] {
+	return &lazy[T]{fn: fn}
+}
@@ -0,0 +1,318 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestOptions_ImplementsLister ensures Options satisfies the Lister interface at compile time.
+func TestOptions_ImplementsLister(t *testing.T) {
+	var _ builderutil.Lister[struct{}] = builderutil.Options[struct{}]{}
+}
+
+// TestOptions_Build tests if Build applies the functions held by an Options slice in order.
+func TestOptions_Build(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	addValue := func(value int) func(*Config) error {
+		return func(c *Config) error {
+			c.Value = c.Value*10 + value
+			return nil
+		}
+	}
+
+	// Build the Config instance from a plain slice literal, including a nil entry
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{addValue(1), nil, addValue(2)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Verify that the functions were applied in order and the nil entry was skipped
+	if config.Value != 12 {
+		t.Errorf("Expected config.Value to be 12, got %d", config.Value)
+	}
+}
+
+// TestOptions_WithCustomLister tests if Options interoperates with custom listers in the same Build call.
+func TestOptions_WithCustomLister(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	addValue := func(value int) func(*Config) error {
+		return func(c *Config) error {
+			c.Value += value
+			return nil
+		}
+	}
+
+	// Mix a custom Lister with an Options slice
+	mockLister := &MockLister[Config]{Funcs: []func(*Config) error{addValue(10)}}
+	options := builderutil.Options[Config]{addValue(5)}
+
+	config, err := builderutil.Build[Config](mockLister, options)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Verify that both listers were applied
+	if config.Value != 15 {
+		t.Errorf("Expected config.Value to be 15, got %d", config.Value)
+	}
+}
+
+// TestOptions_Nil tests if Build handles a nil Options slice gracefully.
+func TestOptions_Nil(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	var options builderutil.Options[Config]
+
+	config, err := builderutil.Build[Config](options)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 0 {
+		t.Errorf("Expected config.Value to be 0, got %d", config.Value)
+	}
+}
+
+// TestFromFuncs tests if a Lister created by FromFuncs can be passed to Build alongside other listers.
+func TestFromFuncs(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	addValue := func(value int) func(*Config) error {
+		return func(c *Config) error {
+			c.Value += value
+			return nil
+		}
+	}
+
+	// Produce the functions programmatically, including a nil entry
+	fns := []func(*Config) error{addValue(1), nil, addValue(2)}
+	lister := builderutil.FromFuncs(fns...)
+
+	// Changing the source slice must not affect the lister
+	fns[0] = addValue(100)
+
+	config, err := builderutil.Build[Config](lister, &MockLister[Config]{Funcs: []func(*Config) error{addValue(10)}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 13 {
+		t.Errorf("Expected config.Value to be 13, got %d", config.Value)
+	}
+}
+
+// TestNoop tests if Noop and NoopLister neither mutate the target nor fail.
+func TestNoop(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	config := &Config{Value: 42}
+	if err := builderutil.Noop[Config]()(config); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+
+	if n := builderutil.Count(builderutil.NoopLister[Config]()); n != 0 {
+		t.Errorf("Expected NoopLister to contribute no functions, got %d", n)
+	}
+
+	built, err := builderutil.BuildFrom(Config{Value: 42}, builderutil.NoopLister[Config](), builderutil.Options[Config]{
+		builderutil.Noop[Config](),
+		builderutil.When(false, func(c *Config) error {
+			c.Value = 7
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if built.Value != 42 {
+		t.Errorf("Expected built.Value to be 42, got %d", built.Value)
+	}
+}
+
+// TestFromOptions_AsOptions tests if options round-trip through FromOptions and AsOptions.
+func TestFromOptions_AsOptions(t *testing.T) {
+	type Config struct {
+		Host string
+		Port int
+	}
+
+	withHost := func(c *Config) { c.Host = "localhost" }
+	withPort := func(c *Config) { c.Port = 8080 }
+
+	lister := builderutil.FromOptions(withHost, nil, withPort)
+
+	opts := builderutil.AsOptions(lister)
+	if len(opts) != 2 {
+		t.Fatalf("Expected 2 options, got %d", len(opts))
+	}
+
+	var config Config
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	expected := Config{Host: "localhost", Port: 8080}
+	if config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, config)
+	}
+
+	built, err := builderutil.Build(lister)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if *built != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *built)
+	}
+}
+
+// TestAsOptions_Panic tests if an adapted option panics when its function fails.
+func TestAsOptions_Panic(t *testing.T) {
+	type Config struct{}
+
+	errFailed := errors.New("error in function")
+
+	opts := builderutil.AsOptions[Config](builderutil.Options[Config]{func(*Config) error {
+		return errFailed
+	}})
+
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, errFailed) {
+			t.Errorf("Expected a panic wrapping %v, got %v", errFailed, err)
+		}
+	}()
+
+	opts[0](&Config{})
+}
+
+// TestChain tests if Chain preserves the ordering of sequential application and ignores nil children.
+func TestChain(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	addDigit := func(digit int) func(*Config) error {
+		return func(c *Config) error {
+			c.Value = c.Value*10 + digit
+			return nil
+		}
+	}
+
+	mockLister1 := &MockLister[Config]{Funcs: []func(*Config) error{addDigit(1), addDigit(2)}}
+	mockLister2 := builderutil.Options[Config]{addDigit(3)}
+
+	var nilLister *MockLister[Config]
+	chained := builderutil.Chain[Config](mockLister1, nil, nilLister, mockLister2)
+
+	// Build once with the chain and once with the listers passed sequentially
+	chainedConfig, err := builderutil.Build[Config](chained)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	sequentialConfig, err := builderutil.Build[Config](mockLister1, mockLister2)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Verify that both builds applied the functions in the same order
+	if chainedConfig.Value != 123 || chainedConfig.Value != sequentialConfig.Value {
+		t.Errorf("Expected both values to be 123, got %d and %d", chainedConfig.Value, sequentialConfig.Value)
+	}
+}
+
+// TestChain_Lazy tests if Chain calls the children's List methods only when its own List is called.
+func TestChain_Lazy(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	mockLister := &MockLister[Config]{}
+	chained := builderutil.Chain[Config](mockLister)
+
+	// Add a function to the child after the chain was created
+	mockLister.Funcs = []func(*Config) error{func(c *Config) error {
+		c.Value = 42
+		return nil
+	}}
+
+	config, err := builderutil.Build[Config](chained)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// TestOptions_TypedNilInChain tests if nil Options and typed nil pointers are skipped inside Build and Chain.
+func TestOptions_TypedNilInChain(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	var nilOptions builderutil.Options[Config]
+	var nilMock *MockLister[Config]
+
+	config, err := builderutil.Build[Config](nilOptions, nilMock, builderutil.Chain[Config](nilOptions, nilMock))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 0 {
+		t.Errorf("Expected config.Value to be 0, got %d", config.Value)
+	}
+}
+
+// TestLazy tests if Lazy defers its function until Build runs and memoizes the result.
+func TestLazy(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	calls := 0
+	lister := builderutil.Lazy(func() []func(*Config) error {
+		calls++
+		return []func(*Config) error{func(c *Config) error {
+			c.Value = 42
+			return nil
+		}}
+	})
+
+	// Nothing is computed until the lister is used
+	if calls != 0 {
+		t.Fatalf("Expected no calls before Build, got %d", calls)
+	}
+
+	for i := 0; i < 3; i++ {
+		config, err := builderutil.Build[Config](lister)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if config.Value != 42 {
+			t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected fn to be called once across builds, got %d", calls)
+	}
+}
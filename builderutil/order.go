@@ -0,0 +1,111 @@
+package builderutil
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// PriorityLister is a Lister that declares where it must run relative to other Listers.
+// Lower priorities run first; Listers that do not implement the interface have priority 0.
+type PriorityLister[T any] interface {
+	Lister[T]
+	// Priority returns the rank of the Lister. Lower values are applied earlier.
+	Priority() int
+}
+
+// priority returns the priority of l, or 0 if it does not implement PriorityLister.
+func priority[T any](l Lister[T]) int {
+
+	if p, ok := l.(PriorityLister[T]); ok {
+		return p.Priority()
+	}
+
+	return 0
+}
+
+// applyPermuted applies opts in the order given by perm, a permutation of their indices.
+// A *BuildError reports the position of the failing Lister in opts, not in perm.
+func applyPermuted[T any](opts []Lister[T], perm []int) (*T, error) {
+
+	ordered := make([]Lister[T], len(perm))
+	for i, idx := range perm {
+		ordered[i] = opts[idx]
+	}
+
+	t := new(T)
+
+	if err := apply(context.Background(), t, ordered, nil); err != nil {
+		var buildErr *BuildError
+		if errors.As(err, &buildErr) {
+			buildErr.ListerIndex = perm[buildErr.ListerIndex]
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// BuildOrdered is like Build but first stable-sorts the Listers ascending by priority, so a
+// "base defaults" bundle can always run first and a "final overrides" bundle always last,
+// regardless of how they are passed. Listers with equal priority keep their relative order.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T], optionally implementing PriorityLister[T].
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError whose ListerIndex is the position of the failing Lister in opts.
+func BuildOrdered[T any](opts ...Lister[T]) (*T, error) {
+
+	perm := make([]int, len(opts))
+	priorities := make([]int, len(opts))
+	for i, opt := range opts {
+		perm[i] = i
+		if !isNilLister(opt) {
+			priorities[i] = priority(opt)
+		}
+	}
+
+	sort.SliceStable(perm, func(a, b int) bool {
+		return priorities[perm[a]] < priorities[perm[b]]
+	})
+
+	return applyPermuted(opts, perm)
+}
+
+// BuildReverse is like Build but applies everything in reverse, giving LIFO semantics for
+// middleware-like layering where later-added behavior wraps earlier behavior. Ordering is
+// reversed at both levels: the last Lister runs first, and within each Lister the last
+// function runs first. For Listers A = [a1, a2] and B = [b1, b2], the functions run in the
+// order b2, b1, a2, a1.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError whose indices refer to the original positions in opts and List.
+func BuildReverse[T any](opts ...Lister[T]) (*T, error) {
+
+	t := new(T)
+
+	for i := len(opts) - 1; i >= 0; i-- {
+		if isNilLister(opts[i]) {
+			continue
+		}
+
+		fns := opts[i].List()
+		for j := len(fns) - 1; j >= 0; j-- {
+
+			if fns[j] == nil {
+				continue
+			}
+
+			if err := fns[j](t); err != nil {
+				return nil, &BuildError{ListerIndex: i, FuncIndex: j, Err: err}
+			}
+
+		}
+	}
+
+	return t, nil
+}
@@ -0,0 +1,101 @@
+package builderutil_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// orderConfig is a configuration type recording the order in which listers ran.
+type orderConfig struct {
+	Steps []string
+}
+
+// record returns a configuration function appending name to the recorded steps.
+func record(name string) func(*orderConfig) error {
+	return func(c *orderConfig) error {
+		c.Steps = append(c.Steps, name)
+		return nil
+	}
+}
+
+// prioritized is a PriorityLister used for testing.
+type prioritized struct {
+	builderutil.Options[orderConfig]
+	priority int
+}
+
+// Priority returns the configured priority.
+func (p prioritized) Priority() int {
+	return p.priority
+}
+
+// TestBuildOrdered tests if listers are applied in priority order even when passed out of order.
+func TestBuildOrdered(t *testing.T) {
+	overrides := prioritized{Options: builderutil.Options[orderConfig]{record("overrides")}, priority: 100}
+	defaults := prioritized{Options: builderutil.Options[orderConfig]{record("defaults")}, priority: -100}
+	first := builderutil.Options[orderConfig]{record("first")}
+	second := builderutil.Options[orderConfig]{record("second")}
+
+	config, err := builderutil.BuildOrdered[orderConfig](overrides, first, nil, defaults, second)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Unprioritized listers keep their relative order at priority 0
+	expected := []string{"defaults", "first", "second", "overrides"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+}
+
+// TestBuildOrdered_ErrorIndex tests if the reported lister index refers to the original position.
+func TestBuildOrdered_ErrorIndex(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	failing := prioritized{Options: builderutil.Options[orderConfig]{func(*orderConfig) error { return errFailed }}, priority: -1}
+
+	_, err := builderutil.BuildOrdered[orderConfig](builderutil.Options[orderConfig]{record("a")}, failing)
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) {
+		t.Fatalf("Expected *BuildError, got %v", err)
+	}
+	if buildErr.ListerIndex != 1 {
+		t.Errorf("Expected ListerIndex to be 1, got %d", buildErr.ListerIndex)
+	}
+}
+
+// TestBuildReverse tests if listers and their functions are applied in reverse order.
+func TestBuildReverse(t *testing.T) {
+	a := builderutil.Options[orderConfig]{record("a1"), record("a2")}
+	b := builderutil.Options[orderConfig]{record("b1"), nil, record("b2")}
+
+	config, err := builderutil.BuildReverse[orderConfig](a, nil, b)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"b2", "b1", "a2", "a1"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+}
+
+// TestBuildReverse_ErrorIndex tests if the reported indices refer to the original positions.
+func TestBuildReverse_ErrorIndex(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	errFunc := func(*orderConfig) error {
+		return errFailed
+	}
+
+	_, err := builderutil.BuildReverse[orderConfig](builderutil.Options[orderConfig]{errFunc, record("a")}, builderutil.Options[orderConfig]{record("b")})
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) || buildErr.ListerIndex != 0 || buildErr.FuncIndex != 0 {
+		t.Fatalf("Expected *BuildError at lister 0, function 0, got %v", err)
+	}
+}
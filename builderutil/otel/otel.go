@@ -0,0 +1,50 @@
+// Package otel provides OpenTelemetry tracing for builderutil builds.
+// It lives in its own package so that the core builderutil package stays dependency-free.
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// BuildTraced is like builderutil.Build but starts a child span of ctx for each configuration
+// function. The span is named after the lister and function indices, records the error
+// returned by the function, if any, and is ended as soon as the function returns.
+// Parameters:
+// - ctx: The context carrying the parent span.
+// - tracer: The tracer used to start the spans.
+// - opts: Variadic arguments of type builderutil.Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *builderutil.BuildError for the failing function.
+func BuildTraced[T any](ctx context.Context, tracer trace.Tracer, opts ...builderutil.Lister[T]) (*T, error) {
+
+	var span trace.Span
+
+	hooks := builderutil.Hooks[T]{
+		Before: func(listerIdx, funcIdx int) {
+			_, span = tracer.Start(ctx, fmt.Sprintf("builderutil.option[%d][%d]", listerIdx, funcIdx),
+				trace.WithAttributes(
+					attribute.Int("builderutil.lister_index", listerIdx),
+					attribute.Int("builderutil.func_index", funcIdx),
+				),
+			)
+		},
+		After: func(_, _ int, err error) {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		},
+	}
+
+	return builderutil.BuildWithHooks(hooks, opts...)
+}
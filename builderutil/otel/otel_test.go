@@ -0,0 +1,89 @@
+package otel_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+	"github.com/zeroxsolutions/go-utils/builderutil/otel"
+)
+
+// TestBuildTraced_NoopTracer tests if BuildTraced builds normally with a no-op tracer.
+func TestBuildTraced_NoopTracer(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	setValue := func(c *Config) error {
+		c.Value = 42
+		return nil
+	}
+
+	tracer := noop.NewTracerProvider().Tracer("test")
+
+	config, err := otel.BuildTraced[Config](context.Background(), tracer, builderutil.Options[Config]{setValue})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// TestBuildTraced_Spans tests if BuildTraced creates one span per function and marks failures.
+func TestBuildTraced_Spans(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	noopFunc := func(*Config) error {
+		return nil
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := provider.Tracer("test")
+
+	_, err := otel.BuildTraced[Config](context.Background(), tracer,
+		builderutil.Options[Config]{noopFunc},
+		builderutil.Options[Config]{nil, errFunc},
+	)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+
+	// Verify that span names carry the indices and only the failing span is errored
+	if spans[0].Name() != "builderutil.option[0][0]" {
+		t.Errorf("Expected first span name builderutil.option[0][0], got %s", spans[0].Name())
+	}
+	if spans[0].Status().Code == codes.Error {
+		t.Error("Expected first span not to be errored")
+	}
+
+	if spans[1].Name() != "builderutil.option[1][1]" {
+		t.Errorf("Expected second span name builderutil.option[1][1], got %s", spans[1].Name())
+	}
+	if spans[1].Status().Code != codes.Error {
+		t.Errorf("Expected second span status to be Error, got %v", spans[1].Status().Code)
+	}
+	if len(spans[1].Events()) == 0 {
+		t.Error("Expected the error to be recorded on the second span")
+	}
+}
@@ -0,0 +1,215 @@
+package builderutil
+
+import (
+	"context"
+	"sync"
+)
+
+// BuildParallel constructs an instance of type T by applying each Lister option in its own
+// goroutine. The functions of a single Lister still run sequentially and in order.
+// When a function fails, the first error is returned and the remaining Listers stop before
+// their next function.
+//
+// Field-level isolation is the caller's responsibility: the Listers must touch disjoint
+// fields of T, otherwise the build contains a data race.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError for the first failing function.
+func BuildParallel[T any](opts ...Lister[T]) (*T, error) {
+
+	t := new(T)
+
+	if err := applyConcurrent(context.Background(), t, nil, opts); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// BuildBounded is like BuildParallel but caps the number of Listers running at the same time
+// at maxConcurrency, so a build with hundreds of I/O-bound options cannot exhaust connections
+// or file descriptors. Listers are started in order as slots free up. The build aborts when
+// ctx is done, both while waiting for a slot and before each function.
+//
+// As with BuildParallel, the Listers must touch disjoint fields of T.
+// Parameters:
+// - ctx: The context that controls cancellation of the build.
+// - maxConcurrency: The maximum number of concurrently running Listers; values below 1 mean 1.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError for the first failing function, or the context error.
+func BuildBounded[T any](ctx context.Context, maxConcurrency int, opts ...Lister[T]) (*T, error) {
+
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	t := new(T)
+
+	if err := applyConcurrent(ctx, t, make(chan struct{}, maxConcurrency), opts); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// applyConcurrent runs every non-nil Lister of opts on target in its own goroutine and returns
+// the first function error, or the error of ctx if it is done before all Listers finished.
+// If sem is not nil, a goroutine is only started once a slot of sem is acquired.
+func applyConcurrent[T any](ctx context.Context, target *T, sem chan struct{}, opts []Lister[T]) error {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+
+	var wg sync.WaitGroup
+
+	for i, opt := range opts {
+		if isNilLister(opt) {
+			continue
+		}
+
+		if sem != nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, opt Lister[T]) {
+			defer wg.Done()
+
+			if sem != nil {
+				defer func() { <-sem }()
+			}
+
+			for j, setArgs := range opt.List() {
+
+				if setArgs == nil {
+					continue
+				}
+
+				if ctx.Err() != nil {
+					return
+				}
+
+				if err := setArgs(target); err != nil {
+					select {
+					case errCh <- &BuildError{ListerIndex: i, FuncIndex: j, Err: err}:
+						cancel()
+					default:
+					}
+					return
+				}
+
+			}
+		}(i, opt)
+	}
+
+	wg.Wait()
+
+	select {
+	case err := <-errCh:
+		return err
+	default:
+		return ctx.Err()
+	}
+}
+
+// BuildSlicePar is like BuildSlice but builds the elements on a pool of worker goroutines,
+// which speeds up bulk construction of many instances with slow options. Each element is
+// built with BuildContext, so the options of a single element still run sequentially, and
+// every element is stored at its own index regardless of completion order.
+//
+// The first failing element cancels the remaining work: pending elements are not started
+// and running ones stop before their next function. Options shared by all elements run
+// concurrently with themselves, so they must not mutate shared state without synchronization.
+// Parameters:
+// - ctx: The context that controls cancellation of the whole job.
+// - n: The number of instances to build. It must not be negative.
+// - workers: The number of worker goroutines; values below 1 mean 1.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A non-nil slice of n pointers to the constructed instances.
+// - ErrNegativeCount, an *ElementError wrapping the first failure observed, or the context error.
+func BuildSlicePar[T any](ctx context.Context, n, workers int, opts ...Lister[T]) ([]*T, error) {
+
+	if n < 0 {
+		return nil, ErrNegativeCount
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > n {
+		workers = n
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ts := make([]*T, n)
+	jobs := make(chan int)
+	errCh := make(chan error, 1)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				t, err := BuildContext(ctx, opts...)
+				if err != nil {
+					if ctx.Err() == nil {
+						select {
+						case errCh <- &ElementError{Index: i, Err: err}:
+							cancel()
+						default:
+						}
+					}
+					continue
+				}
+
+				ts[i] = t
+			}
+		}()
+	}
+
+feed:
+	for i := range ts {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+	default:
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return ts, nil
+}
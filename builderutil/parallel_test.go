@@ -0,0 +1,284 @@
+package builderutil_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildParallel_Speedup tests if BuildParallel applies independent listers concurrently.
+func TestBuildParallel_Speedup(t *testing.T) {
+	type Config struct {
+		A, B, C int
+	}
+
+	delay := 50 * time.Millisecond
+
+	sleepAndSet := func(set func(*Config)) func(*Config) error {
+		return func(c *Config) error {
+			time.Sleep(delay)
+			set(c)
+			return nil
+		}
+	}
+
+	// Each lister touches a different field
+	mockLister1 := &MockLister[Config]{Funcs: []func(*Config) error{sleepAndSet(func(c *Config) { c.A = 1 })}}
+	mockLister2 := &MockLister[Config]{Funcs: []func(*Config) error{sleepAndSet(func(c *Config) { c.B = 2 })}}
+	mockLister3 := &MockLister[Config]{Funcs: []func(*Config) error{sleepAndSet(func(c *Config) { c.C = 3 })}}
+
+	start := time.Now()
+	config, err := builderutil.BuildParallel[Config](mockLister1, mockLister2, nil, mockLister3)
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Verify that every lister was applied
+	if config.A != 1 || config.B != 2 || config.C != 3 {
+		t.Errorf("Expected {1 2 3}, got %+v", *config)
+	}
+
+	// Serial application would take at least three delays
+	if elapsed >= 3*delay {
+		t.Errorf("Expected parallel build to take less than %v, took %v", 3*delay, elapsed)
+	}
+}
+
+// TestBuildParallel_Error tests if BuildParallel propagates an error and stops the remaining work.
+func TestBuildParallel_Error(t *testing.T) {
+	type Config struct {
+		A, B int
+	}
+
+	errFailed := errors.New("error in function")
+
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	applied := make(chan struct{}, 1)
+	slowThenSet := func(c *Config) error {
+		time.Sleep(50 * time.Millisecond)
+		c.B = 1
+		return nil
+	}
+	neverApplied := func(c *Config) error {
+		applied <- struct{}{}
+		return nil
+	}
+
+	mockLister1 := &MockLister[Config]{Funcs: []func(*Config) error{errFunc}}
+	mockLister2 := &MockLister[Config]{Funcs: []func(*Config) error{slowThenSet, neverApplied}}
+
+	config, err := builderutil.BuildParallel[Config](mockLister1, mockLister2)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) || buildErr.ListerIndex != 0 {
+		t.Errorf("Expected *BuildError for lister 0, got %v", err)
+	}
+	if config != nil {
+		t.Errorf("Expected config to be nil, got %v", config)
+	}
+
+	// Verify that the second lister stopped before its next function
+	select {
+	case <-applied:
+		t.Error("Expected remaining functions to be cancelled")
+	default:
+	}
+}
+
+// TestBuildBounded tests if BuildBounded never runs more listers at once than the limit.
+func TestBuildBounded(t *testing.T) {
+	type Config struct {
+		Values [20]int
+	}
+
+	var running, peak int32
+
+	opts := make([]builderutil.Lister[Config], len(Config{}.Values))
+	for i := range opts {
+		i := i
+		opts[i] = builderutil.Options[Config]{func(c *Config) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+
+			time.Sleep(5 * time.Millisecond)
+			c.Values[i] = i
+			return nil
+		}}
+	}
+
+	config, err := builderutil.BuildBounded[Config](context.Background(), 3, opts...)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if p := atomic.LoadInt32(&peak); p > 3 || p < 1 {
+		t.Errorf("Expected at most 3 concurrent listers, got %d", p)
+	}
+
+	for i, v := range config.Values {
+		if v != i {
+			t.Errorf("Expected Values[%d] to be %d, got %d", i, i, v)
+		}
+	}
+}
+
+// TestBuildBounded_Cancelled tests if BuildBounded stops when the context is cancelled.
+func TestBuildBounded_Cancelled(t *testing.T) {
+	type Config struct {
+		A, B int
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancelFunc := func(c *Config) error {
+		cancel()
+		c.A = 1
+		return nil
+	}
+	setB := func(c *Config) error {
+		c.B = 2
+		return nil
+	}
+
+	_, err := builderutil.BuildBounded[Config](ctx, 1, builderutil.Options[Config]{cancelFunc}, builderutil.Options[Config]{setB})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// TestBuildBounded_Error tests if BuildBounded returns the error of a failing function.
+func TestBuildBounded_Error(t *testing.T) {
+	type Config struct {
+		A int
+	}
+
+	errFailed := errors.New("error in function")
+
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	_, err := builderutil.BuildBounded[Config](context.Background(), 0, nil, builderutil.Options[Config]{errFunc})
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) || buildErr.ListerIndex != 1 || !errors.Is(err, errFailed) {
+		t.Fatalf("Expected *BuildError at lister 1 wrapping %v, got %v", errFailed, err)
+	}
+}
+
+// TestBuildSlicePar tests if every element is built once and stored at its own index.
+func TestBuildSlicePar(t *testing.T) {
+	type Config struct {
+		ID int64
+	}
+
+	var next int64
+	assignID := builderutil.Options[Config]{func(c *Config) error {
+		c.ID = atomic.AddInt64(&next, 1)
+		time.Sleep(time.Millisecond)
+		return nil
+	}}
+
+	configs, err := builderutil.BuildSlicePar[Config](context.Background(), 50, 8, assignID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(configs) != 50 {
+		t.Fatalf("Expected 50 configs, got %d", len(configs))
+	}
+
+	seen := make(map[int64]bool)
+	for i, config := range configs {
+		if config == nil {
+			t.Fatalf("Expected element %d to be built", i)
+		}
+		if seen[config.ID] {
+			t.Errorf("Expected element %d to be independent, got duplicate ID %d", i, config.ID)
+		}
+		seen[config.ID] = true
+	}
+
+	if empty, err := builderutil.BuildSlicePar[Config](context.Background(), 0, 4); err != nil || len(empty) != 0 {
+		t.Errorf("Expected an empty slice and no error, got %v and %v", empty, err)
+	}
+
+	if _, err := builderutil.BuildSlicePar[Config](context.Background(), -1, 4); !errors.Is(err, builderutil.ErrNegativeCount) {
+		t.Errorf("Expected ErrNegativeCount, got %v", err)
+	}
+}
+
+// TestBuildSlicePar_Error tests if the first failure is reported with its index and stops further builds.
+func TestBuildSlicePar_Error(t *testing.T) {
+	type Config struct{}
+
+	errFailed := errors.New("error in function")
+
+	var builds int64
+	failOnFifth := builderutil.Options[Config]{func(*Config) error {
+		if atomic.AddInt64(&builds, 1) == 5 {
+			return errFailed
+		}
+		return nil
+	}}
+
+	configs, err := builderutil.BuildSlicePar[Config](context.Background(), 1000, 1, failOnFifth)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	var elemErr *builderutil.ElementError
+	if !errors.As(err, &elemErr) || elemErr.Index != 4 {
+		t.Errorf("Expected *ElementError at index 4, got %v", err)
+	}
+
+	if configs != nil {
+		t.Errorf("Expected nil configs, got %d", len(configs))
+	}
+
+	if n := atomic.LoadInt64(&builds); n > 6 {
+		t.Errorf("Expected the remaining builds to be cancelled, got %d builds", n)
+	}
+}
+
+// TestBuildSlicePar_Cancelled tests if cancelling the context stops further builds.
+func TestBuildSlicePar_Cancelled(t *testing.T) {
+	type Config struct{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var builds int64
+	cancelOnThird := builderutil.Options[Config]{func(*Config) error {
+		if atomic.AddInt64(&builds, 1) == 3 {
+			cancel()
+		}
+		return nil
+	}}
+
+	_, err := builderutil.BuildSlicePar[Config](ctx, 1000, 2, cancelOnThird)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	if n := atomic.LoadInt64(&builds); n > 5 {
+		t.Errorf("Expected the remaining builds to be cancelled, got %d builds", n)
+	}
+}
@@ -0,0 +1,34 @@
+package builderutil
+
+// Reduce threads an immutable value through transformation functions, which suits value
+// types and functional-style configuration that avoids shared mutable state. Each function
+// receives a copy of the current value and returns the next one. Nil functions are skipped.
+// Parameters:
+// - initial: The starting value. Being passed by value, it is never modified.
+// - fns: Variadic transformation functions applied in order.
+//
+// Returns:
+// - The value returned by the last function, or the zero value of T on error.
+// - The error returned by the first failing function.
+func Reduce[T any](initial T, fns ...func(T) (T, error)) (T, error) {
+
+	acc := initial
+
+	for _, fn := range fns {
+
+		if fn == nil {
+			continue
+		}
+
+		next, err := fn(acc)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+
+		acc = next
+
+	}
+
+	return acc, nil
+}
@@ -0,0 +1,66 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// reduceConfig is an immutable-style configuration value.
+type reduceConfig struct {
+	Host string
+	Port int
+}
+
+// TestReduce tests if Reduce accumulates transformations without mutating the initial value.
+func TestReduce(t *testing.T) {
+	withHost := func(c reduceConfig) (reduceConfig, error) {
+		c.Host = "localhost"
+		return c, nil
+	}
+	withPort := func(c reduceConfig) (reduceConfig, error) {
+		c.Port = 8080
+		return c, nil
+	}
+
+	initial := reduceConfig{Port: 80}
+
+	result, err := builderutil.Reduce(initial, withHost, nil, withPort)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result != (reduceConfig{Host: "localhost", Port: 8080}) {
+		t.Errorf("Expected {localhost 8080}, got %+v", result)
+	}
+	if initial != (reduceConfig{Port: 80}) {
+		t.Errorf("Expected initial to be unchanged, got %+v", initial)
+	}
+}
+
+// TestReduce_Error tests if Reduce stops at the first error and returns the zero value.
+func TestReduce_Error(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	calls := 0
+	count := func(c reduceConfig) (reduceConfig, error) {
+		calls++
+		return c, nil
+	}
+	errFunc := func(c reduceConfig) (reduceConfig, error) {
+		return c, errFailed
+	}
+
+	result, err := builderutil.Reduce(reduceConfig{Port: 80}, count, errFunc, count)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if result != (reduceConfig{}) {
+		t.Errorf("Expected the zero value, got %+v", result)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call before the error, got %d", calls)
+	}
+}
@@ -0,0 +1,286 @@
+package builderutil
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ErrNotStruct is returned by reflection-based helpers when T is not a struct type.
+var ErrNotStruct = errors.New("builderutil: target is not a struct")
+
+// ErrUnknownField is returned when a named field does not exist or is not exported.
+var ErrUnknownField = errors.New("builderutil: unknown field")
+
+// ErrTypeMismatch is returned when a value cannot be assigned to a field.
+var ErrTypeMismatch = errors.New("builderutil: type mismatch")
+
+// SetField returns a configuration function that sets the exported field called name to
+// value using reflection. It is a convenience for dynamically-driven configuration;
+// performance-sensitive callers should keep using closures. A nil value resets fields of
+// pointer, slice, map, interface, channel or function type.
+// Parameters:
+// - name: The name of the exported struct field to set.
+// - value: The value to assign. Its type must be assignable to the field type.
+//
+// Returns:
+// - A configuration function that wraps ErrUnknownField, ErrTypeMismatch or ErrNotStruct on failure.
+func SetField[T any](name string, value any) func(*T) error {
+	return func(t *T) error {
+
+		rv, err := settableStruct(t)
+		if err != nil {
+			return err
+		}
+
+		field, err := exportedField(rv, name)
+		if err != nil {
+			return err
+		}
+
+		return assign(field, name, value)
+	}
+}
+
+// SetNestedField is like SetField but resolves a dotted path such as "Base.Timeout" or
+// "Server.TLS.MinVersion" through nested and embedded structs, so options can target fields
+// deep inside a configuration tree. Each segment may name a promoted field of an embedded
+// struct. Nil pointers to structs along the path, embedded ones included, are allocated
+// before the value is assigned. The path is validated against the type of T first, so an
+// invalid path fails without allocating anything.
+// Parameters:
+// - path: The dot-separated names of the exported fields leading to the field to set.
+// - value: The value to assign. Its type must be assignable to the field type.
+//
+// Returns:
+// - A configuration function that wraps ErrUnknownField, ErrTypeMismatch or ErrNotStruct on failure.
+func SetNestedField[T any](path string, value any) func(*T) error {
+	return func(t *T) error {
+
+		rv, err := settableStruct(t)
+		if err != nil {
+			return err
+		}
+
+		indices, err := resolvePath(rv.Type(), path)
+		if err != nil {
+			return err
+		}
+
+		field := rv
+		for _, index := range indices {
+			for _, i := range index {
+				if field.Kind() == reflect.Pointer {
+					if field.IsNil() {
+						field.Set(reflect.New(field.Type().Elem()))
+					}
+					field = field.Elem()
+				}
+
+				field = field.Field(i)
+			}
+		}
+
+		return assign(field, path, value)
+	}
+}
+
+// resolvePath returns the field index sequence of every segment of the dotted path within
+// the struct type typ, checking that each segment is exported and, except for the last,
+// leads to a struct or a pointer to a struct.
+func resolvePath(typ reflect.Type, path string) ([][]int, error) {
+
+	segments := strings.Split(path, ".")
+	indices := make([][]int, len(segments))
+
+	for i, name := range segments {
+		if typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%w %q in path %q: %s is not a struct", ErrUnknownField, name, path, typ)
+		}
+
+		sf, ok := typ.FieldByName(name)
+		if !ok || !sf.IsExported() || !exportedPath(typ, sf.Index) {
+			return nil, fmt.Errorf("%w %q in path %q of %s", ErrUnknownField, name, path, typ)
+		}
+
+		indices[i] = sf.Index
+		typ = sf.Type
+	}
+
+	return indices, nil
+}
+
+// exportedPath reports whether every embedded pointer field along index, a promoted field
+// index of the struct type typ, is exported, so that nil embedded pointers can be allocated.
+func exportedPath(typ reflect.Type, index []int) bool {
+
+	for _, i := range index[:len(index)-1] {
+		if typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+
+		sf := typ.Field(i)
+		if !sf.IsExported() && sf.Type.Kind() == reflect.Pointer {
+			return false
+		}
+
+		typ = sf.Type
+	}
+
+	return true
+}
+
+// structValue returns the struct value pointed to by t, or ErrNotStruct if T is not a struct.
+func structValue[T any](t *T) (reflect.Value, error) {
+
+	rv := reflect.ValueOf(t).Elem()
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%w: %s", ErrNotStruct, rv.Type())
+	}
+
+	return rv, nil
+}
+
+// settableStruct is like structValue for helpers that modify t: it also returns a
+// *FrozenError if t was returned by BuildFrozen.
+func settableStruct[T any](t *T) (reflect.Value, error) {
+
+	if err := checkFrozen(t); err != nil {
+		return reflect.Value{}, err
+	}
+
+	return structValue(t)
+}
+
+// exportedField returns the settable exported field of the struct value rv called name.
+func exportedField(rv reflect.Value, name string) (reflect.Value, error) {
+
+	sf, ok := rv.Type().FieldByName(name)
+	if !ok || !sf.IsExported() {
+		return reflect.Value{}, fmt.Errorf("%w %q in %s", ErrUnknownField, name, rv.Type())
+	}
+
+	field, err := rv.FieldByIndexErr(sf.Index)
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("%w %q in %s: %v", ErrUnknownField, name, rv.Type(), err)
+	}
+
+	return field, nil
+}
+
+// assign stores value into field, reporting ErrTypeMismatch if the types are incompatible.
+func assign(field reflect.Value, name string, value any) error {
+
+	if value == nil {
+		switch field.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		default:
+			return fmt.Errorf("%w: cannot assign nil to field %q of type %s", ErrTypeMismatch, name, field.Type())
+		}
+	}
+
+	v := reflect.ValueOf(value)
+	if !v.Type().AssignableTo(field.Type()) {
+		return fmt.Errorf("%w: cannot assign %s to field %q of type %s", ErrTypeMismatch, v.Type(), name, field.Type())
+	}
+
+	field.Set(v)
+
+	return nil
+}
+
+// FromMap returns a Lister that sets the exported fields of T named by the keys of m to the
+// corresponding values, bridging dynamic configuration such as template data to typed
+// structs. Values must be assignable to their field, except that numbers are coerced between
+// integer and float kinds when no precision or sign is lost, so a float64 42 from a JSON
+// document can set an int field. Keys are applied in sorted order, and m is read when the
+// option is applied.
+// Parameters:
+// - m: The field values keyed by field name.
+//
+// Returns:
+// - A Lister whose single function wraps ErrUnknownField or ErrTypeMismatch on failure.
+func FromMap[T any](m map[string]any) Lister[T] {
+	return Options[T]{func(t *T) error {
+
+		rv, err := settableStruct(t)
+		if err != nil {
+			return err
+		}
+
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			field, err := exportedField(rv, key)
+			if err != nil {
+				return err
+			}
+
+			if err := coerce(field, key, m[key]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}}
+}
+
+// coerce stores value into field like assign, additionally converting between integer and
+// float kinds when the conversion is exact.
+func coerce(field reflect.Value, name string, value any) error {
+
+	v := reflect.ValueOf(value)
+	if value == nil || v.Type().AssignableTo(field.Type()) || !isNumber(v.Kind()) || !isNumber(field.Kind()) {
+		return assign(field, name, value)
+	}
+
+	converted := v.Convert(field.Type())
+
+	if isNegative(v) && isUnsigned(field.Kind()) || isUnsigned(v.Kind()) && isNegative(converted) || isFloat(v.Kind()) && math.IsNaN(v.Float()) || !converted.Convert(v.Type()).Equal(v) {
+		return fmt.Errorf("%w: cannot convert %v to field %q of type %s without loss", ErrTypeMismatch, value, name, field.Type())
+	}
+
+	field.Set(converted)
+
+	return nil
+}
+
+// isNumber reports whether k is an integer or float kind.
+func isNumber(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64 && k != reflect.Uintptr
+}
+
+// isUnsigned reports whether k is an unsigned integer kind.
+func isUnsigned(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uint64
+}
+
+// isNegative reports whether the number held by v is below zero.
+func isNegative(v reflect.Value) bool {
+
+	switch {
+	case v.CanInt():
+		return v.Int() < 0
+	case v.CanFloat():
+		return v.Float() < 0
+	default:
+		return false
+	}
+}
+
+// isFloat reports whether k is a float kind.
+func isFloat(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
@@ -0,0 +1,236 @@
+package builderutil_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// reflectConfig is a configuration type shared by the reflection helper tests.
+type reflectConfig struct {
+	Name    string
+	Port    int
+	Timeout time.Duration
+	Tags    []string
+	secret  string
+}
+
+// TestSetField tests if SetField sets a valid exported field.
+func TestSetField(t *testing.T) {
+	config, err := builderutil.Build[reflectConfig](builderutil.Options[reflectConfig]{
+		builderutil.SetField[reflectConfig]("Name", "api"),
+		builderutil.SetField[reflectConfig]("Timeout", 5*time.Second),
+		builderutil.SetField[reflectConfig]("Tags", nil),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Name != "api" {
+		t.Errorf("Expected config.Name to be api, got %s", config.Name)
+	}
+	if config.Timeout != 5*time.Second {
+		t.Errorf("Expected config.Timeout to be 5s, got %v", config.Timeout)
+	}
+}
+
+// TestSetField_UnknownField tests if SetField reports unknown and unexported fields.
+func TestSetField_UnknownField(t *testing.T) {
+	for _, name := range []string{"Missing", "secret"} {
+		_, err := builderutil.Build[reflectConfig](builderutil.Options[reflectConfig]{builderutil.SetField[reflectConfig](name, "x")})
+		if !errors.Is(err, builderutil.ErrUnknownField) {
+			t.Errorf("Expected ErrUnknownField for %q, got %v", name, err)
+		}
+	}
+}
+
+// TestSetField_TypeMismatch tests if SetField reports a value of an incompatible type.
+func TestSetField_TypeMismatch(t *testing.T) {
+	for _, value := range []any{"8080", nil} {
+		_, err := builderutil.Build[reflectConfig](builderutil.Options[reflectConfig]{builderutil.SetField[reflectConfig]("Port", value)})
+		if !errors.Is(err, builderutil.ErrTypeMismatch) {
+			t.Errorf("Expected ErrTypeMismatch for %v, got %v", value, err)
+		}
+	}
+}
+
+// TestSetField_NotStruct tests if SetField rejects non-struct targets.
+func TestSetField_NotStruct(t *testing.T) {
+	_, err := builderutil.Build[int](builderutil.Options[int]{builderutil.SetField[int]("Value", 1)})
+	if !errors.Is(err, builderutil.ErrNotStruct) {
+		t.Fatalf("Expected ErrNotStruct, got %v", err)
+	}
+}
+
+// nestedBase is a base struct embedded by nestedConfig.
+type nestedBase struct {
+	Timeout time.Duration
+}
+
+// nestedTLS is a struct nested two levels deep in nestedConfig.
+type nestedTLS struct {
+	MinVersion string
+}
+
+// nestedServer is a struct nested by pointer in nestedConfig.
+type nestedServer struct {
+	TLS *nestedTLS
+}
+
+// nestedConfig is a configuration type with embedded and nested structs.
+type nestedConfig struct {
+	*nestedBase
+	Server *nestedServer
+	Name   string
+}
+
+// TestSetNestedField tests if SetNestedField resolves dotted paths and allocates nil pointers.
+func TestSetNestedField(t *testing.T) {
+	config, err := builderutil.Build[nestedConfig](builderutil.Options[nestedConfig]{
+		builderutil.SetNestedField[nestedConfig]("Server.TLS.MinVersion", "1.3"),
+		builderutil.SetNestedField[nestedConfig]("Name", "api"),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Server == nil || config.Server.TLS == nil || config.Server.TLS.MinVersion != "1.3" {
+		t.Errorf("Expected config.Server.TLS.MinVersion to be 1.3, got %+v", config.Server)
+	}
+	if config.Name != "api" {
+		t.Errorf("Expected config.Name to be api, got %s", config.Name)
+	}
+}
+
+// TestSetNestedField_Embedded tests if SetNestedField sets promoted fields of embedded structs.
+func TestSetNestedField_Embedded(t *testing.T) {
+	type embeddedConfig struct {
+		nestedBase
+		Inner struct {
+			nestedBase
+		}
+	}
+
+	config, err := builderutil.Build[embeddedConfig](builderutil.Options[embeddedConfig]{
+		builderutil.SetNestedField[embeddedConfig]("Timeout", time.Second),
+		builderutil.SetNestedField[embeddedConfig]("Inner.Timeout", 2*time.Second),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Timeout != time.Second || config.Inner.Timeout != 2*time.Second {
+		t.Errorf("Expected timeouts 1s and 2s, got %v and %v", config.Timeout, config.Inner.Timeout)
+	}
+}
+
+// TestSetNestedField_InvalidPath tests if SetNestedField reports invalid segments without side effects.
+func TestSetNestedField_InvalidPath(t *testing.T) {
+	for _, path := range []string{"Server.Missing", "Name.Length", "Server..TLS", "Timeout"} {
+		config := &nestedConfig{}
+
+		err := builderutil.Apply(config, builderutil.SetNestedField[nestedConfig](path, "x"))
+		if !errors.Is(err, builderutil.ErrUnknownField) {
+			t.Errorf("Expected ErrUnknownField for %q, got %v", path, err)
+		}
+
+		if config.Server != nil {
+			t.Errorf("Expected no allocation for %q, got %+v", path, config.Server)
+		}
+	}
+
+	err := builderutil.Apply(&nestedConfig{}, builderutil.SetNestedField[nestedConfig]("Server.TLS.MinVersion", 13))
+	if !errors.Is(err, builderutil.ErrTypeMismatch) {
+		t.Errorf("Expected ErrTypeMismatch, got %v", err)
+	}
+}
+
+// TestFromMap tests if FromMap sets matching fields and coerces numbers between int and float kinds.
+func TestFromMap(t *testing.T) {
+	type Config struct {
+		Name  string
+		Port  int
+		Ratio float64
+		Max   uint8
+	}
+
+	m := map[string]any{
+		"Name":  "api",
+		"Port":  float64(8080),
+		"Ratio": 2,
+		"Max":   255,
+	}
+
+	config, err := builderutil.Build[Config](builderutil.FromMap[Config](m))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := Config{Name: "api", Port: 8080, Ratio: 2, Max: 255}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+}
+
+// TestFromMap_UnknownKey tests if FromMap reports unknown keys by name.
+func TestFromMap_UnknownKey(t *testing.T) {
+	_, err := builderutil.Build[reflectConfig](builderutil.FromMap[reflectConfig](map[string]any{"Hostname": "api"}))
+	if !errors.Is(err, builderutil.ErrUnknownField) {
+		t.Fatalf("Expected ErrUnknownField, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), `"Hostname"`) {
+		t.Errorf("Expected error to include the key name, got %v", err)
+	}
+}
+
+// TestFromMap_TypeMismatch tests if FromMap rejects incompatible values and lossy numeric conversions.
+func TestFromMap_TypeMismatch(t *testing.T) {
+	type Config struct {
+		Port int
+		Max  uint8
+	}
+
+	for _, m := range []map[string]any{
+		{"Port": "8080"},
+		{"Port": 80.5},
+		{"Max": 256},
+		{"Max": -1},
+	} {
+		_, err := builderutil.Build[Config](builderutil.FromMap[Config](m))
+		if !errors.Is(err, builderutil.ErrTypeMismatch) {
+			t.Errorf("Expected ErrTypeMismatch for %v, got %v", m, err)
+		}
+	}
+}
+
+// TestFromMap_UnsignedToSigned tests if FromMap rejects unsigned values that wrap around in a signed field.
+func TestFromMap_UnsignedToSigned(t *testing.T) {
+	type Config struct {
+		Small int8
+		Big   int
+	}
+
+	for _, m := range []map[string]any{
+		{"Small": uint8(200)},
+		{"Big": uint64(math.MaxUint64)},
+	} {
+		config, err := builderutil.Build[Config](builderutil.FromMap[Config](m))
+		if !errors.Is(err, builderutil.ErrTypeMismatch) {
+			t.Errorf("Expected ErrTypeMismatch for %v, got %v and %+v", m, err, config)
+		}
+	}
+
+	config, err := builderutil.Build[Config](builderutil.FromMap[Config](map[string]any{"Small": uint8(100)}))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Small != 100 {
+		t.Errorf("Expected config.Small to be 100, got %d", config.Small)
+	}
+}
@@ -0,0 +1,61 @@
+package builderutil
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrUnknownOption is returned when selecting an option name that was never registered.
+var ErrUnknownOption = errors.New("builderutil: unknown option")
+
+// Registry maps names to configuration functions, decoupling the definition of options from
+// their selection at runtime, as in plugin systems. The zero value is an empty Registry ready
+// to use, and its methods are safe for concurrent use.
+type Registry[T any] struct {
+	mu  sync.RWMutex
+	fns map[string]func(*T) error
+}
+
+// Register stores fn under name, replacing any function previously registered with that name.
+// Parameters:
+// - name: The name used to select the option.
+// - fn: The configuration function.
+func (r *Registry[T]) Register(name string, fn func(*T) error) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.fns == nil {
+		r.fns = make(map[string]func(*T) error)
+	}
+
+	r.fns[name] = fn
+}
+
+// Select returns a Lister made of the options registered under names, in the given order.
+// The functions are resolved when Select is called, so later registrations do not affect the
+// returned Lister.
+// Parameters:
+// - names: Variadic option names, in application order.
+//
+// Returns:
+// - A Lister applying the selected options.
+// - An error wrapping ErrUnknownOption with the first name that is not registered.
+func (r *Registry[T]) Select(names ...string) (Lister[T], error) {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	opts := make(Options[T], 0, len(names))
+	for _, name := range names {
+		fn, ok := r.fns[name]
+		if !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnknownOption, name)
+		}
+
+		opts = append(opts, fn)
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,63 @@
+package builderutil_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestRegistry_Select tests if a selected subset is applied in the requested order.
+func TestRegistry_Select(t *testing.T) {
+	type Config struct {
+		Steps []string
+	}
+
+	step := func(name string) func(*Config) error {
+		return func(c *Config) error {
+			c.Steps = append(c.Steps, name)
+			return nil
+		}
+	}
+
+	var registry builderutil.Registry[Config]
+	registry.Register("auth", step("auth"))
+	registry.Register("metrics", step("metrics"))
+	registry.Register("tracing", step("tracing"))
+
+	lister, err := registry.Select("tracing", "auth")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	config, err := builderutil.Build(lister)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"tracing", "auth"}
+	if !reflect.DeepEqual(config.Steps, expected) {
+		t.Errorf("Expected steps %v, got %v", expected, config.Steps)
+	}
+}
+
+// TestRegistry_UnknownName tests if selecting an unregistered name fails with its name.
+func TestRegistry_UnknownName(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	var registry builderutil.Registry[Config]
+	registry.Register("known", func(*Config) error { return nil })
+
+	_, err := registry.Select("known", "missing")
+	if !errors.Is(err, builderutil.ErrUnknownOption) {
+		t.Fatalf("Expected ErrUnknownOption, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), `"missing"`) {
+		t.Errorf("Expected error to include the name, got %v", err)
+	}
+}
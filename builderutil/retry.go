@@ -0,0 +1,114 @@
+package builderutil
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Retry returns a configuration function that calls fn up to attempts times, waiting backoff
+// between consecutive attempts, until fn succeeds. It is meant for options that fetch remote
+// values and may fail transiently. An attempts value below 1 is treated as 1.
+//
+// Failed attempts run against the same instance of T, so fn must be idempotent: it should
+// either leave the instance untouched when it fails or make changes that a later successful
+// attempt fully overwrites.
+// Parameters:
+// - attempts: The maximum number of times fn is called.
+// - backoff: The fixed delay between two attempts.
+// - fn: The configuration function to retry.
+//
+// Returns:
+// - A configuration function returning nil on the first success, or the last error.
+func Retry[T any](attempts int, backoff time.Duration, fn func(*T) error) func(*T) error {
+
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	return func(t *T) error {
+
+		var err error
+
+		for i := 0; i < attempts; i++ {
+			if i > 0 && backoff > 0 {
+				time.Sleep(backoff)
+			}
+
+			if err = fn(t); err == nil {
+				return nil
+			}
+		}
+
+		return err
+	}
+}
+
+// BuildRetry is like BuildContext but retries the whole build as a unit until it succeeds or
+// attempts are exhausted, for builds that depend on a flaky external system. Every attempt
+// starts from a fresh instance of T, so options need not be idempotent. After the n-th failed
+// attempt, BuildRetry waits backoff(n) before the next one, which lets callers implement
+// exponential or jittered backoff; a nil backoff retries immediately. An attempts value below
+// 1 is treated as 1.
+//
+// ctx is checked by every attempt and between attempts. Once it is done, BuildRetry gives up
+// and returns the last build error joined with the context error.
+// Parameters:
+// - ctx: The context that controls cancellation of the retries.
+// - attempts: The maximum number of builds.
+// - backoff: Returns the delay after the given 1-based failed attempt.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the instance of T built by the first successful attempt.
+// - The error of the last attempt, joined with the context error if ctx stopped the retries.
+func BuildRetry[T any](ctx context.Context, attempts int, backoff func(attempt int) time.Duration, opts ...Lister[T]) (*T, error) {
+
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		var t *T
+		if t, err = BuildContext(ctx, opts...); err == nil {
+			return t, nil
+		}
+
+		if attempt == attempts {
+			return nil, err
+		}
+
+		var delay time.Duration
+		if backoff != nil {
+			delay = backoff(attempt)
+		}
+
+		if ctxErr := wait(ctx, delay); ctxErr != nil {
+			if errors.Is(err, ctxErr) {
+				return nil, err
+			}
+			return nil, errors.Join(err, ctxErr)
+		}
+	}
+}
+
+// wait blocks for delay or until ctx is done, whichever happens first, and returns the
+// context error in the latter case.
+func wait(ctx context.Context, delay time.Duration) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
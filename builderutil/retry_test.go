@@ -0,0 +1,173 @@
+package builderutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestRetry_EventualSuccess tests if Retry applies a function that fails twice and then succeeds.
+func TestRetry_EventualSuccess(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errTransient := errors.New("transient error")
+
+	calls := 0
+	flaky := func(c *Config) error {
+		calls++
+		if calls < 3 {
+			return errTransient
+		}
+		c.Value = 42
+		return nil
+	}
+
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{builderutil.Retry(3, time.Millisecond, flaky)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// TestRetry_Exhausted tests if Retry returns the last error once all attempts fail.
+func TestRetry_Exhausted(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	calls := 0
+	failing := func(*Config) error {
+		calls++
+		return errors.New("attempt failed")
+	}
+
+	err := builderutil.Retry(2, 0, failing)(&Config{})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+}
+
+// TestRetry_MinimumOneAttempt tests if Retry calls the function once when attempts is not positive.
+func TestRetry_MinimumOneAttempt(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	calls := 0
+	count := func(*Config) error {
+		calls++
+		return nil
+	}
+
+	if err := builderutil.Retry(0, 0, count)(&Config{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+}
+
+// TestBuildRetry tests if BuildRetry rebuilds from scratch and stops at the first success.
+func TestBuildRetry(t *testing.T) {
+	type Config struct {
+		Values []int
+	}
+
+	errTransient := errors.New("transient error")
+
+	var builds int
+	var delays []int
+	flaky := builderutil.Options[Config]{func(c *Config) error {
+		builds++
+		c.Values = append(c.Values, builds)
+		if builds < 3 {
+			return errTransient
+		}
+		return nil
+	}}
+	backoff := func(attempt int) time.Duration {
+		delays = append(delays, attempt)
+		return time.Millisecond << attempt
+	}
+
+	config, err := builderutil.BuildRetry[Config](context.Background(), 5, backoff, flaky)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if builds != 3 {
+		t.Errorf("Expected 3 builds, got %d", builds)
+	}
+	if len(config.Values) != 1 || config.Values[0] != 3 {
+		t.Errorf("Expected a fresh instance holding [3], got %v", config.Values)
+	}
+	if len(delays) != 2 || delays[0] != 1 || delays[1] != 2 {
+		t.Errorf("Expected backoff for attempts [1 2], got %v", delays)
+	}
+}
+
+// TestBuildRetry_Exhausted tests if BuildRetry returns the last error after every attempt failed.
+func TestBuildRetry_Exhausted(t *testing.T) {
+	type Config struct{}
+
+	errTransient := errors.New("transient error")
+
+	var builds int
+	failing := builderutil.Options[Config]{func(*Config) error {
+		builds++
+		return errTransient
+	}}
+
+	_, err := builderutil.BuildRetry[Config](context.Background(), 3, nil, failing)
+	if !errors.Is(err, errTransient) {
+		t.Fatalf("Expected %v, got %v", errTransient, err)
+	}
+
+	if builds != 3 {
+		t.Errorf("Expected 3 builds, got %d", builds)
+	}
+}
+
+// TestBuildRetry_Cancelled tests if BuildRetry stops waiting when the context is cancelled.
+func TestBuildRetry_Cancelled(t *testing.T) {
+	type Config struct{}
+
+	errTransient := errors.New("transient error")
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var builds int
+	failing := builderutil.Options[Config]{func(*Config) error {
+		builds++
+		cancel()
+		return errTransient
+	}}
+	backoff := func(int) time.Duration {
+		return time.Hour
+	}
+
+	_, err := builderutil.BuildRetry[Config](ctx, 3, backoff, failing)
+	if !errors.Is(err, errTransient) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected %v joined with context.Canceled, got %v", errTransient, err)
+	}
+
+	if builds != 1 {
+		t.Errorf("Expected 1 build, got %d", builds)
+	}
+}
@@ -0,0 +1,66 @@
+package builderutil
+
+import (
+	"errors"
+)
+
+// Undoer is a generic interface for options that can be reverted.
+// Each function in the list configures the instance of T and, on success, returns an
+// undo closure that reverts what it did, for example closing a file it opened.
+// A nil undo closure means there is nothing to revert.
+type Undoer[T any] interface {
+	// List returns a slice of functions, each of which modifies the instance of T and
+	// returns an undo closure, or returns an error if the modification fails.
+	List() []func(*T) (func() error, error)
+}
+
+// BuildWithRollback constructs and configures an instance of type T like Build, but with
+// transactional semantics. If any function fails, the undo closures of every function
+// applied so far are invoked in reverse order before returning. Undo closures are run
+// even if some of them fail, and their errors are joined to the returned error.
+// Parameters:
+// - opts: Variadic arguments of type Undoer[T] that provide revertible configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError for the failing function, joined with any rollback errors.
+func BuildWithRollback[T any](opts ...Undoer[T]) (*T, error) {
+
+	t := new(T)
+
+	var undos []func() error
+
+	for i, opt := range opts {
+		if isNil(opt) {
+			continue
+		}
+
+		for j, setArgs := range opt.List() {
+
+			if setArgs == nil {
+				continue
+			}
+
+			undo, err := setArgs(t)
+			if err != nil {
+				errs := []error{&BuildError{ListerIndex: i, FuncIndex: j, Err: err}}
+
+				for k := len(undos) - 1; k >= 0; k-- {
+					if undoErr := undos[k](); undoErr != nil {
+						errs = append(errs, undoErr)
+					}
+				}
+
+				return nil, errors.Join(errs...)
+			}
+
+			if undo != nil {
+				undos = append(undos, undo)
+			}
+
+		}
+
+	}
+
+	return t, nil
+}
@@ -0,0 +1,116 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// MockUndoer is a mock implementation of the Undoer interface for testing purposes.
+type MockUndoer[T any] struct {
+	Funcs []func(*T) (func() error, error)
+}
+
+// List returns the list of functions that MockUndoer holds for testing.
+func (m *MockUndoer[T]) List() []func(*T) (func() error, error) {
+	return m.Funcs
+}
+
+// TestBuildWithRollback_Success tests if BuildWithRollback applies options without undoing them on success.
+func TestBuildWithRollback_Success(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	undone := 0
+	setValue := func(c *Config) (func() error, error) {
+		c.Value = 42
+		return func() error {
+			undone++
+			return nil
+		}, nil
+	}
+
+	config, err := builderutil.BuildWithRollback[Config](&MockUndoer[Config]{Funcs: []func(*Config) (func() error, error){setValue, nil}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+	if undone != 0 {
+		t.Errorf("Expected no undo calls, got %d", undone)
+	}
+}
+
+// TestBuildWithRollback_UndoOnFailure tests if the undo closures of applied options run once, in reverse order.
+func TestBuildWithRollback_UndoOnFailure(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	var undone []int
+	setValue := func(value int) func(*Config) (func() error, error) {
+		return func(c *Config) (func() error, error) {
+			c.Value = value
+			return func() error {
+				undone = append(undone, value)
+				return nil
+			}, nil
+		}
+	}
+	errFunc := func(*Config) (func() error, error) {
+		return nil, errFailed
+	}
+
+	// The option in the second lister fails after two options were applied
+	mockUndoer1 := &MockUndoer[Config]{Funcs: []func(*Config) (func() error, error){setValue(1), setValue(2)}}
+	mockUndoer2 := &MockUndoer[Config]{Funcs: []func(*Config) (func() error, error){errFunc, setValue(3)}}
+
+	config, err := builderutil.BuildWithRollback[Config](mockUndoer1, mockUndoer2)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if config != nil {
+		t.Errorf("Expected config to be nil, got %v", config)
+	}
+
+	// Verify that each applied option was undone exactly once in reverse order
+	if len(undone) != 2 || undone[0] != 2 || undone[1] != 1 {
+		t.Errorf("Expected undo order [2 1], got %v", undone)
+	}
+}
+
+// TestBuildWithRollback_UndoErrors tests if rollback errors are attached to the returned error.
+func TestBuildWithRollback_UndoErrors(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+	errUndo := errors.New("error in undo")
+
+	setValue := func(c *Config) (func() error, error) {
+		return func() error {
+			return errUndo
+		}, nil
+	}
+	errFunc := func(*Config) (func() error, error) {
+		return nil, errFailed
+	}
+
+	_, err := builderutil.BuildWithRollback[Config](&MockUndoer[Config]{Funcs: []func(*Config) (func() error, error){setValue, errFunc}})
+
+	// Verify that both the build error and the rollback error are reported
+	if !errors.Is(err, errFailed) {
+		t.Errorf("Expected error to match %v", errFailed)
+	}
+	if !errors.Is(err, errUndo) {
+		t.Errorf("Expected error to match %v", errUndo)
+	}
+}
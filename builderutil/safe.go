@@ -0,0 +1,145 @@
+package builderutil
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is returned when a configuration function panics during a safe build.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the goroutine at the time of the panic.
+	Stack []byte
+}
+
+// Error returns a message including the recovered value.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("builderutil: option panicked: %v", e.Value)
+}
+
+// Unwrap returns the recovered value if it is an error, so that errors.Is and errors.As
+// can inspect it, and nil otherwise.
+func (e *PanicError) Unwrap() error {
+
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// wrapped is a Lister that decorates every function of an underlying Lister.
+// Nil functions are kept as nil so that indices and skipping rules are unchanged.
+type wrapped[T any] struct {
+	lister Lister[T]
+	wrap   func(func(*T) error) func(*T) error
+}
+
+// List returns the functions of the underlying Lister, each decorated with wrap.
+func (w wrapped[T]) List() []func(*T) error {
+
+	fns := w.lister.List()
+	if fns == nil {
+		return nil
+	}
+
+	out := make([]func(*T) error, len(fns))
+	for i, fn := range fns {
+		if fn != nil {
+			out[i] = w.wrap(fn)
+		}
+	}
+
+	return out
+}
+
+// wrapAll decorates the functions of every non-nil Lister in opts with wrap.
+// Nil Listers stay nil so the positions of the returned Listers match opts.
+func wrapAll[T any](wrap func(func(*T) error) func(*T) error, opts []Lister[T]) []Lister[T] {
+
+	out := make([]Lister[T], len(opts))
+	for i, opt := range opts {
+		if !isNilLister(opt) {
+			out[i] = wrapped[T]{lister: opt, wrap: wrap}
+		}
+	}
+
+	return out
+}
+
+// safe returns a function that calls fn and converts a panic into a *PanicError.
+func safe[T any](fn func(*T) error) func(*T) error {
+	return func(t *T) (err error) {
+
+		defer func() {
+			if r := recover(); r != nil {
+				err = &PanicError{Value: r, Stack: debug.Stack()}
+			}
+		}()
+
+		return fn(t)
+	}
+}
+
+// BuildSafe is like Build but recovers from panics raised by configuration functions,
+// converting them into errors instead of crashing the goroutine. This protects long-running
+// servers that build configurations from plugin-provided options.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError for the failing function; if it panicked, its Err is a *PanicError.
+func BuildSafe[T any](opts ...Lister[T]) (*T, error) {
+	return Build(wrapAll(safe[T], opts)...)
+}
+
+// BuildIsolated combines BuildSafe with context cancellation for hosting untrusted plugin
+// options. Each configuration function runs in its own monitored goroutine on a deep copy of
+// the instance, which is committed only if the function returns successfully. A panic is
+// converted into a *PanicError, and if ctx is done before the function returns, the function
+// is abandoned and the context error is returned. In both cases the built instance keeps the
+// state left by the preceding functions, never a partial write.
+//
+// An abandoned function keeps running in its goroutine until it returns. Options must not
+// retain the target pointer after returning, and should stop promptly once ctx is done, since
+// their goroutines cannot be stopped from outside.
+// Parameters:
+// - ctx: The context that controls cancellation of the build.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError for the failing or abandoned function, wrapping a *PanicError or the context error.
+func BuildIsolated[T any](ctx context.Context, opts ...Lister[T]) (*T, error) {
+
+	t := new(T)
+
+	err := apply(ctx, t, opts, func(_, _ int, fn func(*T) error) error {
+
+		staged := deepClone(t)
+
+		done := make(chan error, 1)
+		go func() {
+			done <- safe(fn)(staged)
+		}()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				return err
+			}
+			cloneInto(t, staged)
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -0,0 +1,150 @@
+package builderutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildSafe_Panic tests if BuildSafe converts a panicking option into a *PanicError.
+func TestBuildSafe_Panic(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	noop := func(*Config) error {
+		return nil
+	}
+	panicFunc := func(*Config) error {
+		panic("boom")
+	}
+
+	config, err := builderutil.BuildSafe[Config](nil, &MockLister[Config]{Funcs: []func(*Config) error{noop, nil, panicFunc}})
+	if config != nil {
+		t.Errorf("Expected config to be nil, got %v", config)
+	}
+
+	var panicErr *builderutil.PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("Expected *PanicError, got %v", err)
+	}
+	if panicErr.Value != "boom" {
+		t.Errorf("Expected recovered value to be boom, got %v", panicErr.Value)
+	}
+	if len(panicErr.Stack) == 0 {
+		t.Error("Expected a stack trace to be captured")
+	}
+
+	// Verify that the indices of the panicking option are reported
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) || buildErr.ListerIndex != 1 || buildErr.FuncIndex != 2 {
+		t.Errorf("Expected *BuildError at lister 1, function 2, got %v", err)
+	}
+}
+
+// TestBuildSafe_PanicWithError tests if a panic value that is an error can be matched with errors.Is.
+func TestBuildSafe_PanicWithError(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+	panicFunc := func(*Config) error {
+		panic(errFailed)
+	}
+
+	_, err := builderutil.BuildSafe[Config](&MockLister[Config]{Funcs: []func(*Config) error{panicFunc}})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+}
+
+// TestBuildSafe_Success tests if BuildSafe behaves like Build when no option panics.
+func TestBuildSafe_Success(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	setValue := func(c *Config) error {
+		c.Value = 42
+		return nil
+	}
+
+	config, err := builderutil.BuildSafe[Config](&MockLister[Config]{Funcs: []func(*Config) error{setValue}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+}
+
+// TestBuildIsolated_Panic tests if a panicking option becomes an error without corrupting state.
+func TestBuildIsolated_Panic(t *testing.T) {
+	type Config struct {
+		Tags []string
+	}
+
+	setTags := func(c *Config) error {
+		c.Tags = []string{"a"}
+		return nil
+	}
+	panicking := func(c *Config) error {
+		c.Tags[0] = "corrupted"
+		panic("plugin crashed")
+	}
+
+	var seen []string
+	inspect := func(c *Config) error {
+		seen = c.Tags
+		return nil
+	}
+
+	_, err := builderutil.BuildIsolated[Config](context.Background(), builderutil.Options[Config]{setTags, panicking, inspect})
+
+	var panicErr *builderutil.PanicError
+	if !errors.As(err, &panicErr) || panicErr.Value != "plugin crashed" {
+		t.Fatalf("Expected *PanicError with value 'plugin crashed', got %v", err)
+	}
+	if seen != nil {
+		t.Errorf("Expected the build to stop at the panicking option, got %v", seen)
+	}
+
+	config, err := builderutil.BuildIsolated[Config](context.Background(), builderutil.Options[Config]{setTags, inspect})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(config.Tags) != 1 || config.Tags[0] != "a" {
+		t.Errorf("Expected config.Tags to be [a], got %v", config.Tags)
+	}
+}
+
+// TestBuildIsolated_Cancelled tests if a blocked option is abandoned when the context is cancelled.
+func TestBuildIsolated_Cancelled(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	release := make(chan struct{})
+	defer close(release)
+
+	blocking := func(c *Config) error {
+		cancel()
+		<-release
+		c.Value = 42
+		return nil
+	}
+
+	config, err := builderutil.BuildIsolated[Config](ctx, builderutil.Options[Config]{blocking})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if config != nil {
+		t.Errorf("Expected nil config, got %+v", config)
+	}
+}
@@ -0,0 +1,86 @@
+package builderutil
+
+import "context"
+
+// Progress reports how far a streaming build has advanced.
+type Progress struct {
+	// Completed is the number of configuration functions applied successfully so far.
+	Completed int
+	// Total is the number of non-nil configuration functions of the build.
+	Total int
+}
+
+// Result is the outcome of a streaming build.
+type Result[T any] struct {
+	// Value is the constructed instance, or nil if the build failed.
+	Value *T
+	// Err is the error that stopped the build, or nil on success.
+	Err error
+}
+
+// BuildStream is like Build but runs in a new goroutine and reports its progress over a
+// channel, for example to drive a progress bar in a UI. The functions of every Lister are
+// listed up front to compute the total, then one Progress value is sent after each applied
+// function, with Completed increasing by one up to Total on success. The progress channel is
+// buffered for the whole build, so the build never waits for a slow or absent reader.
+// Both channels are closed when the build is done; the progress channel is closed first, and
+// the result channel delivers exactly one Result before being closed.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A channel of Progress values in apply order.
+// - A channel delivering the constructed instance or a *BuildError for the failing function.
+func BuildStream[T any](opts ...Lister[T]) (<-chan Progress, <-chan Result[T]) {
+
+	listed := make([]Lister[T], len(opts))
+
+	var total int
+	for i, opt := range opts {
+		if isNilLister(opt) {
+			continue
+		}
+
+		fns := Options[T](opt.List())
+		for _, fn := range fns {
+			if fn != nil {
+				total++
+			}
+		}
+
+		listed[i] = fns
+	}
+
+	progress := make(chan Progress, total)
+	result := make(chan Result[T], 1)
+
+	go func() {
+		defer close(result)
+
+		t := new(T)
+
+		var completed int
+		err := apply(context.Background(), t, listed, func(_, _ int, fn func(*T) error) error {
+
+			if err := fn(t); err != nil {
+				return err
+			}
+
+			completed++
+			progress <- Progress{Completed: completed, Total: total}
+
+			return nil
+		})
+
+		close(progress)
+
+		if err != nil {
+			result <- Result[T]{Err: err}
+			return
+		}
+
+		result <- Result[T]{Value: t}
+	}()
+
+	return progress, result
+}
@@ -0,0 +1,85 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildStream tests if progress counts increase monotonically to the total.
+func TestBuildStream(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	increment := func(c *Config) error {
+		c.Value++
+		return nil
+	}
+
+	progress, result := builderutil.BuildStream[Config](builderutil.Options[Config]{increment, nil, increment}, nil, builderutil.Options[Config]{increment})
+
+	var last int
+	for p := range progress {
+		if p.Total != 3 {
+			t.Errorf("Expected total to be 3, got %d", p.Total)
+		}
+		if p.Completed != last+1 {
+			t.Errorf("Expected completed to be %d, got %d", last+1, p.Completed)
+		}
+		last = p.Completed
+	}
+
+	if last != 3 {
+		t.Errorf("Expected progress to reach 3, got %d", last)
+	}
+
+	r, ok := <-result
+	if !ok {
+		t.Fatalf("Expected a result")
+	}
+	if r.Err != nil {
+		t.Fatalf("Expected no error, got %v", r.Err)
+	}
+	if r.Value.Value != 3 {
+		t.Errorf("Expected Value to be 3, got %d", r.Value.Value)
+	}
+
+	if _, ok := <-result; ok {
+		t.Errorf("Expected the result channel to be closed")
+	}
+}
+
+// TestBuildStream_Error tests if a failing function is delivered as the result.
+func TestBuildStream_Error(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	increment := func(c *Config) error {
+		c.Value++
+		return nil
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	progress, result := builderutil.BuildStream[Config](builderutil.Options[Config]{increment, errFunc, increment})
+
+	// The result can be read without draining the progress channel
+	r := <-result
+	if !errors.Is(r.Err, errFailed) || r.Value != nil {
+		t.Fatalf("Expected %v and no value, got %+v", errFailed, r)
+	}
+
+	var updates int
+	for range progress {
+		updates++
+	}
+	if updates != 1 {
+		t.Errorf("Expected 1 progress update, got %d", updates)
+	}
+}
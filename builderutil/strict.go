@@ -0,0 +1,62 @@
+package builderutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrFieldConflict is returned by BuildStrict when two options write the same field.
+var ErrFieldConflict = errors.New("builderutil: field written by several options")
+
+// BuildStrict is like Build but fails when a configuration function changes an exported
+// top-level field that an earlier function already changed, which surfaces conflicting
+// option bundles that would otherwise silently override each other. Writing a field with
+// the value it already holds is not a change, and func fields are compared by identity. The
+// conflict error wraps ErrFieldConflict and names the field together with the function that
+// changed it first.
+//
+// The target is deep-copied and compared field by field around every function, so this is
+// meant as a debug mode for tests and development builds, not for hot paths.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - ErrNotStruct if T is not a struct, or a *BuildError for the failing or conflicting function.
+func BuildStrict[T any](opts ...Lister[T]) (*T, error) {
+
+	t := new(T)
+
+	rv, err := structValue(t)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[int][2]int)
+
+	err = apply(context.Background(), t, opts, func(i, j int, fn func(*T) error) error {
+
+		before := reflect.ValueOf(deepClone(t)).Elem()
+
+		if err := fn(t); err != nil {
+			return err
+		}
+
+		for _, k := range changedFields(before, rv) {
+			if owner, ok := owners[k]; ok {
+				return fmt.Errorf("%w: %s was already changed by option %d of lister %d", ErrFieldConflict, rv.Type().Field(k).Name, owner[1], owner[0])
+			}
+
+			owners[k] = [2]int{i, j}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
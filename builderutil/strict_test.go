@@ -0,0 +1,115 @@
+package builderutil_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// strictConfig is a configuration type used by the BuildStrict tests.
+type strictConfig struct {
+	Host string
+	Port int
+	Tags []string
+}
+
+// TestBuildStrict tests if options writing disjoint fields build without error.
+func TestBuildStrict(t *testing.T) {
+	setHost := func(c *strictConfig) error {
+		c.Host = "localhost"
+		return nil
+	}
+	setPort := func(c *strictConfig) error {
+		c.Port = 8080
+		return nil
+	}
+	keepHost := func(c *strictConfig) error {
+		c.Host = "localhost"
+		return nil
+	}
+
+	config, err := builderutil.BuildStrict[strictConfig](builderutil.Options[strictConfig]{setHost}, builderutil.Options[strictConfig]{setPort, keepHost})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Host != "localhost" || config.Port != 8080 {
+		t.Errorf("Expected Host 'localhost' and Port 8080, got %+v", *config)
+	}
+}
+
+// TestBuildStrict_Conflict tests if a second write to the same field is reported by field name.
+func TestBuildStrict_Conflict(t *testing.T) {
+	setTags := func(c *strictConfig) error {
+		c.Tags = []string{"a"}
+		return nil
+	}
+	setPort := func(c *strictConfig) error {
+		c.Port = 8080
+		return nil
+	}
+	editTags := func(c *strictConfig) error {
+		c.Tags[0] = "b"
+		return nil
+	}
+
+	_, err := builderutil.BuildStrict[strictConfig](builderutil.Options[strictConfig]{setTags}, builderutil.Options[strictConfig]{setPort, editTags})
+	if !errors.Is(err, builderutil.ErrFieldConflict) {
+		t.Fatalf("Expected ErrFieldConflict, got %v", err)
+	}
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) || buildErr.ListerIndex != 1 || buildErr.FuncIndex != 1 {
+		t.Errorf("Expected *BuildError at lister 1, function 1, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "Tags") {
+		t.Errorf("Expected the error to name the field, got %v", err)
+	}
+}
+
+// TestBuildStrict_NotStruct tests if BuildStrict rejects non-struct types.
+func TestBuildStrict_NotStruct(t *testing.T) {
+	_, err := builderutil.BuildStrict[int]()
+	if !errors.Is(err, builderutil.ErrNotStruct) {
+		t.Fatalf("Expected ErrNotStruct, got %v", err)
+	}
+}
+
+// TestBuildStrict_FuncField tests if an unchanged func field is not reported as a conflict.
+func TestBuildStrict_FuncField(t *testing.T) {
+	type Config struct {
+		OnErr func(error)
+		Host  string
+		Port  int
+	}
+
+	setOnErr := func(c *Config) error {
+		c.OnErr = func(error) {}
+		return nil
+	}
+	setPort := func(c *Config) error {
+		c.Port = 8080
+		return nil
+	}
+	resetOnErr := func(c *Config) error {
+		c.OnErr = nil
+		return nil
+	}
+
+	config, err := builderutil.BuildStrict[Config](builderutil.Options[Config]{setOnErr, setPort})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.OnErr == nil || config.Port != 8080 {
+		t.Errorf("Expected OnErr to be set and Port 8080, got %+v", *config)
+	}
+
+	_, err = builderutil.BuildStrict[Config](builderutil.Options[Config]{setOnErr, setPort, resetOnErr})
+	if !errors.Is(err, builderutil.ErrFieldConflict) || !strings.Contains(err.Error(), "OnErr") {
+		t.Errorf("Expected ErrFieldConflict naming OnErr, got %v", err)
+	}
+}
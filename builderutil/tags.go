@@ -0,0 +1,181 @@
+package builderutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// ErrUnsupportedKind is returned when a struct field cannot be populated from a string.
+var ErrUnsupportedKind = errors.New("builderutil: unsupported field kind")
+
+// durationType is the reflect.Type of time.Duration, which is parsed with time.ParseDuration.
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// setFromString parses s according to the type of field and stores the result.
+// Supported types are strings, booleans, signed and unsigned integers, floats and time.Duration.
+func setFromString(field reflect.Value, s string) error {
+
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedKind, field.Type())
+	}
+
+	return nil
+}
+
+// applyTagDefaults sets every zero-valued exported field of the struct pointed to by t
+// from its "default" struct tag.
+func applyTagDefaults[T any](t *T) error {
+
+	rv, err := settableStruct(t)
+	if err != nil {
+		return err
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+
+		def, ok := sf.Tag.Lookup("default")
+		if !ok || !sf.IsExported() {
+			continue
+		}
+
+		field := rv.Field(i)
+		if !field.IsZero() {
+			continue
+		}
+
+		if err := setFromString(field, def); err != nil {
+			return fmt.Errorf("builderutil: invalid default for field %s: %w", sf.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// populateFromTag sets every exported field of the struct pointed to by t that carries the
+// struct tag key. The tag value is passed to lookup, and fields for which lookup reports
+// no value are skipped. The source describes the origin of values in error messages.
+func populateFromTag[T any](t *T, key, source string, lookup func(name string) (string, bool, error)) error {
+
+	rv, err := settableStruct(t)
+	if err != nil {
+		return err
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+
+		name, ok := sf.Tag.Lookup(key)
+		if !ok || name == "" || name == "-" || !sf.IsExported() {
+			continue
+		}
+
+		value, found, err := lookup(name)
+		if err != nil {
+			return fmt.Errorf("builderutil: reading %s %q for field %s: %w", source, name, sf.Name, err)
+		}
+		if !found {
+			continue
+		}
+
+		if err := setFromString(rv.Field(i), value); err != nil {
+			return fmt.Errorf("builderutil: parsing %s %q for field %s: %w", source, name, sf.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// FromTag returns a Lister that populates the exported fields of T carrying the struct tag key
+// from lookup, which is called with each tag value. It is the building block of FromEnv and
+// FromKV, and lets other packages source fields from their own stores, for example:
+//
+//	builderutil.FromTag[Config]("secret", "secret", vault.Lookup)
+//
+// Fields for which lookup reports no value are left untouched. Values are parsed into the field
+// type; supported types are string, bool, integer, float and time.Duration. The option refuses
+// frozen targets, like the other reflective setters.
+// Parameters:
+// - key: The struct tag naming each field's value, such as "env".
+// - source: Describes the origin of the values in error messages, such as "environment variable".
+// - lookup: Returns the value for a tag value, whether it exists, or a read error.
+//
+// Returns:
+// - A Lister whose single function fails with the field and tag value on a read or parse error.
+func FromTag[T any](key, source string, lookup func(name string) (string, bool, error)) Lister[T] {
+	return Options[T]{func(t *T) error {
+		return populateFromTag(t, key, source, lookup)
+	}}
+}
+
+// BuildWithTagDefaults is like Build but first populates fields from their "default" struct
+// tags, for example:
+//
+//	type Config struct {
+//		Timeout time.Duration `default:"30s"`
+//	}
+//
+// Defaults are applied before any option, and only to fields that are still zero, so options
+// can override them. Supported field types are string, bool, integer, float and time.Duration.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - An error if a default tag cannot be parsed or any configuration function fails.
+func BuildWithTagDefaults[T any](opts ...Lister[T]) (*T, error) {
+
+	t := new(T)
+
+	if err := applyTagDefaults(t); err != nil {
+		return nil, err
+	}
+
+	if err := apply(context.Background(), t, opts, nil); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
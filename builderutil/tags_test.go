@@ -0,0 +1,111 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// taggedConfig is a configuration type declaring defaults in struct tags.
+type taggedConfig struct {
+	Host     string        `default:"localhost"`
+	Port     int           `default:"8080"`
+	Debug    bool          `default:"true"`
+	Timeout  time.Duration `default:"30s"`
+	Untagged string
+}
+
+// TestBuildWithTagDefaults tests if defaults are populated from struct tags.
+func TestBuildWithTagDefaults(t *testing.T) {
+	config, err := builderutil.BuildWithTagDefaults[taggedConfig]()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := taggedConfig{Host: "localhost", Port: 8080, Debug: true, Timeout: 30 * time.Second}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+}
+
+// TestBuildWithTagDefaults_OptionWins tests if an explicit option overrides a tag default.
+func TestBuildWithTagDefaults_OptionWins(t *testing.T) {
+	setPort := func(c *taggedConfig) error {
+		c.Port = 9090
+		return nil
+	}
+
+	config, err := builderutil.BuildWithTagDefaults[taggedConfig](builderutil.Options[taggedConfig]{setPort})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Port != 9090 {
+		t.Errorf("Expected config.Port to be 9090, got %d", config.Port)
+	}
+	if config.Host != "localhost" {
+		t.Errorf("Expected config.Host to be localhost, got %s", config.Host)
+	}
+}
+
+// TestBuildWithTagDefaults_InvalidTag tests if an unparsable default is reported.
+func TestBuildWithTagDefaults_InvalidTag(t *testing.T) {
+	type Config struct {
+		Port int `default:"eighty"`
+	}
+
+	_, err := builderutil.BuildWithTagDefaults[Config]()
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	type SliceConfig struct {
+		Hosts []string `default:"a,b"`
+	}
+
+	_, err = builderutil.BuildWithTagDefaults[SliceConfig]()
+	if !errors.Is(err, builderutil.ErrUnsupportedKind) {
+		t.Fatalf("Expected ErrUnsupportedKind, got %v", err)
+	}
+}
+
+// TestFromTag tests if fields are populated from a custom lookup and missing values are skipped.
+func TestFromTag(t *testing.T) {
+	type Config struct {
+		Host string `secret:"host"`
+		Port int    `secret:"port"`
+		User string `secret:"user"`
+	}
+
+	values := map[string]string{"host": "db", "port": "5432"}
+	lookup := func(name string) (string, bool, error) {
+		value, ok := values[name]
+		return value, ok, nil
+	}
+
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{
+		func(c *Config) error {
+			c.User = "admin"
+			return nil
+		},
+	}, builderutil.FromTag[Config]("secret", "secret", lookup))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := Config{Host: "db", Port: 5432, User: "admin"}
+	if *config != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *config)
+	}
+
+	errRead := errors.New("read failed")
+	failing := func(string) (string, bool, error) {
+		return "", false, errRead
+	}
+
+	if _, err := builderutil.Build[Config](builderutil.FromTag[Config]("secret", "secret", failing)); !errors.Is(err, errRead) {
+		t.Errorf("Expected %v, got %v", errRead, err)
+	}
+}
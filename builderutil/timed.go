@@ -0,0 +1,37 @@
+package builderutil
+
+import (
+	"context"
+	"time"
+)
+
+// BuildTimed is like Build but also measures the wall-clock duration of each configuration
+// function, which helps find the culprit when a build is slow. Durations are reported in
+// apply order; skipped nil functions have no entry.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - The duration of every function that ran, including a failing one.
+// - A *BuildError for the failing function.
+func BuildTimed[T any](opts ...Lister[T]) (*T, []time.Duration, error) {
+
+	t := new(T)
+
+	var durations []time.Duration
+
+	err := apply(context.Background(), t, opts, func(_, _ int, fn func(*T) error) error {
+
+		start := time.Now()
+		err := fn(t)
+		durations = append(durations, time.Since(start))
+
+		return err
+	})
+	if err != nil {
+		return nil, durations, err
+	}
+
+	return t, durations, nil
+}
@@ -0,0 +1,73 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildTimed tests if BuildTimed reports per-function durations in apply order.
+func TestBuildTimed(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	sleep := func(d time.Duration) func(*Config) error {
+		return func(*Config) error {
+			time.Sleep(d)
+			return nil
+		}
+	}
+
+	mockLister := &MockLister[Config]{Funcs: []func(*Config) error{sleep(40 * time.Millisecond), nil, sleep(5 * time.Millisecond)}}
+
+	_, durations, err := builderutil.BuildTimed[Config](mockLister)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(durations) != 2 {
+		t.Fatalf("Expected 2 durations, got %d", len(durations))
+	}
+
+	// Verify that the durations are in the expected ballpark and ordering
+	if durations[0] < 40*time.Millisecond {
+		t.Errorf("Expected first duration to be at least 40ms, got %v", durations[0])
+	}
+	if durations[1] < 5*time.Millisecond {
+		t.Errorf("Expected second duration to be at least 5ms, got %v", durations[1])
+	}
+	if durations[0] <= durations[1] {
+		t.Errorf("Expected first duration to exceed the second, got %v and %v", durations[0], durations[1])
+	}
+}
+
+// TestBuildTimed_Error tests if BuildTimed returns the durations collected before the failure.
+func TestBuildTimed_Error(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	errFailed := errors.New("error in function")
+
+	noop := func(*Config) error {
+		return nil
+	}
+	errFunc := func(*Config) error {
+		return errFailed
+	}
+
+	config, durations, err := builderutil.BuildTimed[Config](&MockLister[Config]{Funcs: []func(*Config) error{noop, errFunc, noop}})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if config != nil {
+		t.Errorf("Expected config to be nil, got %v", config)
+	}
+	if len(durations) != 2 {
+		t.Errorf("Expected 2 durations, got %d", len(durations))
+	}
+}
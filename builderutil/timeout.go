@@ -0,0 +1,117 @@
+package builderutil
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrOptionTimeout is returned when a configuration function does not finish in time.
+var ErrOptionTimeout = errors.New("builderutil: option timed out")
+
+// BuildWithTimeout is like Build but gives each configuration function at most perOption to
+// complete, so a single slow remote fetch cannot stall the whole build. Each function runs in
+// its own goroutine on a deep staging copy of the instance, which is copied back only if the
+// function returns in time. A function that times out is abandoned: its goroutine keeps
+// running, but its writes to the staging copy, including to its pointers, slices and maps,
+// never reach the built instance or any data shared with it. Channels, functions and
+// unexported fields cannot be copied and remain shared.
+//
+// An abandoned function keeps consuming resources until it returns, so functions must respect
+// cancellation and stop promptly. Plain option functions cannot observe the timeout; functions
+// that need to should be passed to BuildWithTimeoutContext instead, which hands each of them a
+// context cancelled when its time is up. A non-positive perOption disables the timeout.
+// Parameters:
+// - perOption: The maximum duration of each configuration function.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError identifying the failing function; for a timeout it wraps ErrOptionTimeout.
+func BuildWithTimeout[T any](perOption time.Duration, opts ...Lister[T]) (*T, error) {
+
+	if perOption <= 0 {
+		return Build(opts...)
+	}
+
+	t := new(T)
+
+	err := apply(context.Background(), t, opts, func(_, _ int, fn func(*T) error) error {
+		return runWithTimeout(context.Background(), perOption, t, func(_ context.Context, t *T) error {
+			return fn(t)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// BuildWithTimeoutContext is like BuildWithTimeout for context-aware configuration functions.
+// Each function receives a context derived from ctx that is cancelled as soon as the function
+// exceeds perOption, or when ctx is done, so abandoned work such as a remote fetch can stop
+// instead of running to completion. The build aborts with the context error once ctx is done.
+// A non-positive perOption disables the timeout, and every function then receives ctx.
+// Parameters:
+// - ctx: The context that controls cancellation of the build.
+// - perOption: The maximum duration of each configuration function.
+// - fns: Variadic context-aware configuration functions to apply in order.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A *BuildError identifying the failing function; for a timeout it wraps ErrOptionTimeout.
+func BuildWithTimeoutContext[T any](ctx context.Context, perOption time.Duration, fns ...func(context.Context, *T) error) (*T, error) {
+
+	opts := make(Options[T], len(fns))
+	for j, fn := range fns {
+		if fn != nil {
+			opts[j] = WithContext(ctx, fn)
+		}
+	}
+
+	if perOption <= 0 {
+		return BuildContext[T](ctx, opts)
+	}
+
+	t := new(T)
+
+	err := apply(ctx, t, []Lister[T]{opts}, func(_, j int, _ func(*T) error) error {
+		return runWithTimeout(ctx, perOption, t, fns[j])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// runWithTimeout runs fn in its own goroutine on a deep copy of t, with a context derived from
+// ctx that is cancelled after perOption, and commits the copy back to t only if fn succeeds in
+// time. It returns ErrOptionTimeout if fn is abandoned, or the error of ctx if it is done.
+func runWithTimeout[T any](ctx context.Context, perOption time.Duration, t *T, fn func(context.Context, *T) error) error {
+
+	optCtx, cancel := context.WithTimeout(ctx, perOption)
+	defer cancel()
+
+	staged := deepClone(t)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(optCtx, staged)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+		cloneInto(t, staged)
+		return nil
+	case <-optCtx.Done():
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return ErrOptionTimeout
+	}
+}
@@ -0,0 +1,145 @@
+package builderutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestBuildWithTimeout_FastOptions tests if options finishing in time are applied.
+func TestBuildWithTimeout_FastOptions(t *testing.T) {
+	type Config struct {
+		A, B int
+	}
+
+	setA := func(c *Config) error {
+		c.A = 1
+		return nil
+	}
+	setB := func(c *Config) error {
+		c.B = c.A + 1
+		return nil
+	}
+
+	config, err := builderutil.BuildWithTimeout[Config](time.Second, builderutil.Options[Config]{setA, setB})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.A != 1 || config.B != 2 {
+		t.Errorf("Expected {1 2}, got %+v", *config)
+	}
+}
+
+// TestBuildWithTimeout_SlowOption tests if a slow option triggers a timeout error identifying it.
+func TestBuildWithTimeout_SlowOption(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	release := make(chan struct{})
+	defer close(release)
+
+	fast := func(c *Config) error {
+		return nil
+	}
+	slow := func(c *Config) error {
+		<-release
+		c.Value = 42
+		return nil
+	}
+
+	start := time.Now()
+	config, err := builderutil.BuildWithTimeout[Config](20*time.Millisecond, builderutil.Options[Config]{fast, slow})
+	if !errors.Is(err, builderutil.ErrOptionTimeout) {
+		t.Fatalf("Expected ErrOptionTimeout, got %v", err)
+	}
+
+	var buildErr *builderutil.BuildError
+	if !errors.As(err, &buildErr) || buildErr.FuncIndex != 1 {
+		t.Errorf("Expected *BuildError for function 1, got %v", err)
+	}
+	if config != nil {
+		t.Errorf("Expected config to be nil, got %v", config)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the build to be abandoned quickly, took %v", elapsed)
+	}
+}
+
+// TestBuildWithTimeout_Isolation tests if an abandoned option cannot modify data set by earlier options.
+func TestBuildWithTimeout_Isolation(t *testing.T) {
+	type Config struct {
+		Labels map[string]string
+	}
+
+	labels := map[string]string{"env": "prod"}
+	abandoned := make(chan struct{})
+
+	setLabels := func(c *Config) error {
+		c.Labels = labels
+		return nil
+	}
+	slow := func(c *Config) error {
+		time.Sleep(50 * time.Millisecond)
+		c.Labels["env"] = "changed"
+		close(abandoned)
+		return nil
+	}
+
+	_, err := builderutil.BuildWithTimeout[Config](10*time.Millisecond, builderutil.Options[Config]{setLabels, slow})
+	if !errors.Is(err, builderutil.ErrOptionTimeout) {
+		t.Fatalf("Expected ErrOptionTimeout, got %v", err)
+	}
+
+	<-abandoned
+
+	if labels["env"] != "prod" {
+		t.Errorf("Expected the labels to be untouched, got %v", labels)
+	}
+}
+
+// TestBuildWithTimeoutContext tests if a timed-out function sees its context cancelled.
+func TestBuildWithTimeoutContext(t *testing.T) {
+	type Config struct {
+		A, B int
+	}
+
+	cancelled := make(chan error, 1)
+
+	setA := func(_ context.Context, c *Config) error {
+		c.A = 1
+		return nil
+	}
+	wait := func(ctx context.Context, _ *Config) error {
+		<-ctx.Done()
+		cancelled <- ctx.Err()
+		return ctx.Err()
+	}
+
+	config, err := builderutil.BuildWithTimeoutContext[Config](context.Background(), time.Second, setA)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.A != 1 {
+		t.Errorf("Expected config.A to be 1, got %d", config.A)
+	}
+
+	_, err = builderutil.BuildWithTimeoutContext[Config](context.Background(), 10*time.Millisecond, setA, wait)
+	if !errors.Is(err, builderutil.ErrOptionTimeout) {
+		t.Fatalf("Expected ErrOptionTimeout, got %v", err)
+	}
+
+	select {
+	case err := <-cancelled:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected the abandoned function to see its context cancelled")
+	}
+}
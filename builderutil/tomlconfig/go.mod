@@ -0,0 +1,8 @@
+module github.com/zeroxsolutions/go-utils/builderutil/tomlconfig
+
+go 1.21
+
+require (
+	github.com/BurntSushi/toml v1.4.0
+	github.com/zeroxsolutions/go-utils v0.1.0
+)
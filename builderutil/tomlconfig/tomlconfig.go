@@ -0,0 +1,24 @@
+// Package tomlconfig provides a builderutil Lister that loads configuration from TOML.
+// It lives in its own module so that the core builderutil package stays dependency-free.
+package tomlconfig
+
+import (
+	"github.com/BurntSushi/toml"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// FromTOML returns a Lister that applies the TOML document data onto the target.
+// Only the keys present in the document are changed, including inside nested tables.
+// Decode errors are reported as an error from the option function during Build, in which
+// case the target is left untouched.
+// Parameters:
+// - data: The TOML document to decode.
+//
+// Returns:
+// - A Lister whose single function decodes data onto the target.
+func FromTOML[T any](data []byte) builderutil.Lister[T] {
+	return builderutil.FromDecoder[T](func(v any) error {
+		return toml.Unmarshal(data, v)
+	})
+}
@@ -0,0 +1,90 @@
+package tomlconfig_test
+
+import (
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+	"github.com/zeroxsolutions/go-utils/builderutil/tomlconfig"
+)
+
+// Config is a configuration type with nested tables loaded from TOML.
+type Config struct {
+	Name     string   `toml:"name"`
+	Server   Server   `toml:"server"`
+	Features []string `toml:"features"`
+}
+
+// Server is the nested part of Config.
+type Server struct {
+	Host string `toml:"host"`
+	Port int    `toml:"port"`
+	TLS  TLS    `toml:"tls"`
+}
+
+// TLS is nested two levels deep in Config.
+type TLS struct {
+	MinVersion string `toml:"min_version"`
+}
+
+// TestFromTOML tests if FromTOML populates fields, including nested tables.
+func TestFromTOML(t *testing.T) {
+	data := []byte(`
+name = "api"
+features = ["metrics", "tracing"]
+
+[server]
+host = "localhost"
+port = 8080
+
+[server.tls]
+min_version = "1.3"
+`)
+
+	config, err := builderutil.Build[Config](tomlconfig.FromTOML[Config](data))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Name != "api" {
+		t.Errorf("Expected config.Name to be api, got %s", config.Name)
+	}
+	if config.Server.Host != "localhost" || config.Server.Port != 8080 {
+		t.Errorf("Expected server localhost:8080, got %+v", config.Server)
+	}
+	if config.Server.TLS.MinVersion != "1.3" {
+		t.Errorf("Expected TLS min version 1.3, got %s", config.Server.TLS.MinVersion)
+	}
+	if len(config.Features) != 2 || config.Features[1] != "tracing" {
+		t.Errorf("Expected features [metrics tracing], got %v", config.Features)
+	}
+}
+
+// TestFromTOML_Partial tests if a partial document keeps values set by earlier options.
+func TestFromTOML_Partial(t *testing.T) {
+	setDefaults := func(c *Config) error {
+		c.Name = "default"
+		c.Server = Server{Host: "0.0.0.0", Port: 80}
+		return nil
+	}
+
+	config, err := builderutil.Build[Config](
+		builderutil.Options[Config]{setDefaults},
+		tomlconfig.FromTOML[Config]([]byte("[server]\nport = 8080\n")),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := Server{Host: "0.0.0.0", Port: 8080}
+	if config.Name != "default" || config.Server != expected {
+		t.Errorf("Expected default name and server %+v, got %+v", expected, *config)
+	}
+}
+
+// TestFromTOML_Invalid tests if a decode error surfaces during Build.
+func TestFromTOML_Invalid(t *testing.T) {
+	_, err := builderutil.Build[Config](tomlconfig.FromTOML[Config]([]byte("[server\nport = ")))
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
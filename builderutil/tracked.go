@@ -0,0 +1,52 @@
+package builderutil
+
+import (
+	"context"
+	"reflect"
+)
+
+// BuildTracked is like Build but also records the provenance of every field, answering
+// "where did this value come from?" in layered configuration. Around each configuration
+// function the target is deep-copied and compared field by field; every exported top-level
+// field the function changed is attributed to the index of its Lister in opts. When several
+// Listers change the same field, the last one wins, matching the value the field ends up with.
+// Fields no option changed are absent from the map. The snapshots make this considerably
+// slower than Build, so it is meant for diagnostics.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - A map from changed field name to the index of the last Lister that changed it.
+// - ErrNotStruct if T is not a struct, or a *BuildError for the failing function.
+func BuildTracked[T any](opts ...Lister[T]) (*T, map[string]int, error) {
+
+	t := new(T)
+
+	rv, err := structValue(t)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sources := make(map[string]int)
+
+	err = apply(context.Background(), t, opts, func(i, _ int, fn func(*T) error) error {
+
+		before := reflect.ValueOf(deepClone(t)).Elem()
+
+		if err := fn(t); err != nil {
+			return err
+		}
+
+		for _, k := range changedFields(before, rv) {
+			sources[rv.Type().Field(k).Name] = i
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return t, sources, nil
+}
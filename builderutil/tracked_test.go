@@ -0,0 +1,93 @@
+package builderutil_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// trackedConfig is a configuration type used by the provenance tests.
+type trackedConfig struct {
+	Host  string
+	Port  int
+	Debug bool
+}
+
+// TestBuildTracked tests if the provenance map records the last lister that set each field.
+func TestBuildTracked(t *testing.T) {
+	defaults := builderutil.Options[trackedConfig]{func(c *trackedConfig) error {
+		c.Host = "localhost"
+		c.Port = 80
+		return nil
+	}}
+	file := builderutil.Options[trackedConfig]{func(c *trackedConfig) error {
+		c.Port = 8080
+		return nil
+	}}
+	flags := builderutil.Options[trackedConfig]{func(c *trackedConfig) error {
+		c.Host = "localhost"
+		return nil
+	}}
+
+	config, sources, err := builderutil.BuildTracked[trackedConfig](defaults, nil, file, flags)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Host != "localhost" || config.Port != 8080 {
+		t.Errorf("Expected Host 'localhost' and Port 8080, got %+v", *config)
+	}
+
+	// flags wrote Host with the value it already had, so defaults keeps the credit
+	expected := map[string]int{"Host": 0, "Port": 2}
+	if !reflect.DeepEqual(sources, expected) {
+		t.Errorf("Expected sources %v, got %v", expected, sources)
+	}
+}
+
+// TestBuildTracked_Error tests if BuildTracked returns the error of a failing option.
+func TestBuildTracked_Error(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	errFunc := func(*trackedConfig) error {
+		return errFailed
+	}
+
+	config, sources, err := builderutil.BuildTracked[trackedConfig](builderutil.Options[trackedConfig]{errFunc})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if config != nil || sources != nil {
+		t.Errorf("Expected nil results, got %+v and %v", config, sources)
+	}
+}
+
+// TestBuildTracked_FuncField tests if a func field is attributed only to the Lister that set it.
+func TestBuildTracked_FuncField(t *testing.T) {
+	type Config struct {
+		OnErr func(error)
+		Port  int
+	}
+
+	setOnErr := func(c *Config) error {
+		c.OnErr = func(error) {}
+		return nil
+	}
+	setPort := func(c *Config) error {
+		c.Port = 8080
+		return nil
+	}
+
+	_, provenance, err := builderutil.BuildTracked[Config](builderutil.Options[Config]{setOnErr}, builderutil.Options[Config]{setPort})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := map[string]int{"OnErr": 0, "Port": 1}
+	if !reflect.DeepEqual(provenance, expected) {
+		t.Errorf("Expected provenance %v, got %v", expected, provenance)
+	}
+}
@@ -0,0 +1,197 @@
+package builderutil
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrRequired is reported by ValidateTags for a required field left at its zero value.
+var ErrRequired = errors.New("builderutil: required field is not set")
+
+// ErrOutOfRange is reported by ValidateTags for a numeric field outside its min/max bounds.
+var ErrOutOfRange = errors.New("builderutil: field out of range")
+
+// ErrInvalidTag is returned by ValidateTags for a malformed validate struct tag.
+var ErrInvalidTag = errors.New("builderutil: invalid validate tag")
+
+// Validator is implemented by types that can check their own cross-field invariants once
+// every option has been applied, for example "either A or B must be set".
+type Validator interface {
+	// Validate returns an error if the instance is not in a valid state.
+	Validate() error
+}
+
+// BuildValidated constructs and configures an instance of type T like Build and then, if *T
+// implements Validator, calls Validate on the finished instance. Types that do not
+// implement the interface are returned as soon as all options are applied.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - An error if any configuration function fails, or a *ValidationError if validation fails.
+func BuildValidated[T any](opts ...Lister[T]) (*T, error) {
+
+	t, err := Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := any(t).(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, &ValidationError{Err: err}
+		}
+	}
+
+	return t, nil
+}
+
+// Finalizer is implemented by types that need a commit step once every option has been
+// applied successfully, such as flushing registered handlers.
+type Finalizer interface {
+	// Finalize completes the construction of the instance or returns an error.
+	Finalize() error
+}
+
+// BuildFinalized constructs and configures an instance of type T like Build and then, if *T
+// implements Finalizer, calls Finalize exactly once on the finished instance. Finalize is not
+// called when an option fails. Types that do not implement the interface are unaffected.
+// Parameters:
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - An error if any configuration function fails, or the error returned by Finalize.
+func BuildFinalized[T any](opts ...Lister[T]) (*T, error) {
+
+	t, err := Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if f, ok := any(t).(Finalizer); ok {
+		if err := f.Finalize(); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// ValidateTags returns a configuration function enforcing declarative checks written in the
+// "validate" struct tag of the exported fields of T, for simple cases where implementing
+// Validator is overkill. It is meant to be passed last, after every other option has run.
+// The tag holds comma-separated rules:
+//
+//	type Config struct {
+//		Host string `validate:"required"`
+//		Port int    `validate:"required,min=1,max=65535"`
+//	}
+//
+// The rule required rejects zero values, and min and max bound signed integer, unsigned
+// integer and float fields inclusively. Every violated rule of every field is reported.
+//
+// Returns:
+// - A configuration function returning a *ValidationError that joins every violation.
+// - Violations wrap ErrRequired or ErrOutOfRange; malformed tags fail with ErrInvalidTag.
+func ValidateTags[T any]() func(*T) error {
+	return func(t *T) error {
+
+		rv, err := structValue(t)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+
+		rt := rv.Type()
+		for i := 0; i < rt.NumField(); i++ {
+			sf := rt.Field(i)
+
+			tag, ok := sf.Tag.Lookup("validate")
+			if !ok || !sf.IsExported() {
+				continue
+			}
+
+			for _, rule := range strings.Split(tag, ",") {
+				violation, err := checkRule(rv.Field(i), sf.Name, rule)
+				if err != nil {
+					return err
+				}
+
+				if violation != nil {
+					errs = append(errs, violation)
+				}
+			}
+		}
+
+		if len(errs) > 0 {
+			return &ValidationError{Err: errors.Join(errs...)}
+		}
+
+		return nil
+	}
+}
+
+// checkRule checks field against a single rule of a validate tag. It returns the violation,
+// if any, or an error wrapping ErrInvalidTag if the rule cannot be applied to the field.
+func checkRule(field reflect.Value, name, rule string) (violation, err error) {
+
+	if rule == "required" {
+		if field.IsZero() {
+			return fmt.Errorf("%w: %s", ErrRequired, name), nil
+		}
+		return nil, nil
+	}
+
+	op, bound, ok := strings.Cut(rule, "=")
+	if !ok || (op != "min" && op != "max") {
+		return nil, fmt.Errorf("%w: unknown rule %q on field %s", ErrInvalidTag, rule, name)
+	}
+
+	var cmp int
+	var parseErr error
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var b int64
+		b, parseErr = strconv.ParseInt(bound, 10, 64)
+		cmp = compare(field.Int(), b)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var b uint64
+		b, parseErr = strconv.ParseUint(bound, 10, 64)
+		cmp = compare(field.Uint(), b)
+	case reflect.Float32, reflect.Float64:
+		var b float64
+		b, parseErr = strconv.ParseFloat(bound, 64)
+		cmp = compare(field.Float(), b)
+	default:
+		return nil, fmt.Errorf("%w: rule %q on non-numeric field %s", ErrInvalidTag, rule, name)
+	}
+
+	if parseErr != nil {
+		return nil, fmt.Errorf("%w: rule %q on field %s: %v", ErrInvalidTag, rule, name, parseErr)
+	}
+
+	if (op == "min" && cmp < 0) || (op == "max" && cmp > 0) {
+		return fmt.Errorf("%w: %s is %v, %s is %s", ErrOutOfRange, name, field, op, bound), nil
+	}
+
+	return nil, nil
+}
+
+// compare returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b.
+func compare[N int64 | uint64 | float64](a, b N) int {
+
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,205 @@
+package builderutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// errMissingEndpoint is returned by validatedConfig when neither field is set.
+var errMissingEndpoint = errors.New("either Host or Socket must be set")
+
+// validatedConfig is a configuration type implementing the Validator interface.
+type validatedConfig struct {
+	Host   string
+	Socket string
+}
+
+// Validate requires that at least one of Host and Socket is set.
+func (c *validatedConfig) Validate() error {
+	if c.Host == "" && c.Socket == "" {
+		return errMissingEndpoint
+	}
+	return nil
+}
+
+// TestBuildValidated_Fails tests if BuildValidated returns the error reported by Validate.
+func TestBuildValidated_Fails(t *testing.T) {
+	config, err := builderutil.BuildValidated[validatedConfig]()
+	if !errors.Is(err, errMissingEndpoint) {
+		t.Fatalf("Expected %v, got %v", errMissingEndpoint, err)
+	}
+
+	var validationErr *builderutil.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("Expected *ValidationError, got %T", err)
+	}
+	if config != nil {
+		t.Errorf("Expected config to be nil, got %v", config)
+	}
+}
+
+// TestBuildValidated_Passes tests if BuildValidated returns the instance when Validate succeeds.
+func TestBuildValidated_Passes(t *testing.T) {
+	setHost := func(c *validatedConfig) error {
+		c.Host = "localhost"
+		return nil
+	}
+
+	config, err := builderutil.BuildValidated[validatedConfig](builderutil.Options[validatedConfig]{setHost})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Host != "localhost" {
+		t.Errorf("Expected config.Host to be localhost, got %s", config.Host)
+	}
+}
+
+// TestBuildValidated_NotValidator tests if BuildValidated skips validation for types without Validate.
+func TestBuildValidated_NotValidator(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	config, err := builderutil.BuildValidated[Config]()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config == nil {
+		t.Fatal("Expected config to be non-nil")
+	}
+}
+
+// finalizedConfig is a configuration type implementing the Finalizer interface.
+type finalizedConfig struct {
+	Handlers  []string
+	Finalized []string
+	Err       error
+}
+
+// Finalize records the handlers seen at finalization time and returns the configured error.
+func (c *finalizedConfig) Finalize() error {
+	c.Finalized = append(c.Finalized, c.Handlers...)
+	return c.Err
+}
+
+// TestBuildFinalized tests if the finalizer runs exactly once after all options.
+func TestBuildFinalized(t *testing.T) {
+	register := func(name string) func(*finalizedConfig) error {
+		return func(c *finalizedConfig) error {
+			c.Handlers = append(c.Handlers, name)
+			return nil
+		}
+	}
+
+	config, err := builderutil.BuildFinalized[finalizedConfig](builderutil.Options[finalizedConfig]{register("a"), register("b")})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Finalize saw every handler, which proves it ran after the options, and ran only once
+	if len(config.Finalized) != 2 || config.Finalized[0] != "a" || config.Finalized[1] != "b" {
+		t.Errorf("Expected finalized handlers [a b], got %v", config.Finalized)
+	}
+}
+
+// TestBuildFinalized_Error tests if the finalizer error propagates.
+func TestBuildFinalized_Error(t *testing.T) {
+	errFinalize := errors.New("finalize failed")
+
+	setErr := func(c *finalizedConfig) error {
+		c.Err = errFinalize
+		return nil
+	}
+
+	config, err := builderutil.BuildFinalized[finalizedConfig](builderutil.Options[finalizedConfig]{setErr})
+	if !errors.Is(err, errFinalize) {
+		t.Fatalf("Expected %v, got %v", errFinalize, err)
+	}
+
+	if config != nil {
+		t.Errorf("Expected config to be nil, got %v", config)
+	}
+}
+
+// TestBuildFinalized_NotFinalizer tests if types without Finalize are built normally.
+func TestBuildFinalized_NotFinalizer(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	config, err := builderutil.BuildFinalized[Config]()
+	if err != nil || config == nil {
+		t.Fatalf("Expected a config and no error, got %v and %v", config, err)
+	}
+}
+
+// validateTagsConfig is a configuration type declaring its checks in validate tags.
+type validateTagsConfig struct {
+	Host    string  `validate:"required"`
+	Port    int     `validate:"required,min=1,max=65535"`
+	Workers uint    `validate:"max=8"`
+	Ratio   float64 `validate:"min=0.5"`
+	Name    string
+}
+
+// TestValidateTags tests if ValidateTags accepts a configuration satisfying every rule.
+func TestValidateTags(t *testing.T) {
+	config := &validateTagsConfig{Host: "localhost", Port: 8080, Workers: 8, Ratio: 0.5}
+
+	if err := builderutil.Apply(config, builderutil.ValidateTags[validateTagsConfig]()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+// TestValidateTags_Violations tests if ValidateTags reports every required and range violation.
+func TestValidateTags_Violations(t *testing.T) {
+	config := &validateTagsConfig{Workers: 9, Ratio: 0.25}
+
+	err := builderutil.Apply(config, builderutil.ValidateTags[validateTagsConfig]())
+
+	var validationErr *builderutil.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected *ValidationError, got %v", err)
+	}
+
+	if !errors.Is(err, builderutil.ErrRequired) || !errors.Is(err, builderutil.ErrOutOfRange) {
+		t.Errorf("Expected ErrRequired and ErrOutOfRange, got %v", err)
+	}
+
+	// Host and Port are missing, Port is below min, Workers is above max and Ratio is below min
+	if n := len(validationErr.Err.(interface{ Unwrap() []error }).Unwrap()); n != 5 {
+		t.Errorf("Expected 5 violations, got %d: %v", n, err)
+	}
+
+	config = &validateTagsConfig{Host: "localhost", Port: 70000, Ratio: 1}
+	if err := builderutil.Apply(config, builderutil.ValidateTags[validateTagsConfig]()); !errors.Is(err, builderutil.ErrOutOfRange) {
+		t.Errorf("Expected ErrOutOfRange, got %v", err)
+	}
+}
+
+// TestValidateTags_InvalidTag tests if ValidateTags rejects malformed rules.
+func TestValidateTags_InvalidTag(t *testing.T) {
+	type unknownRule struct {
+		Port int `validate:"positive"`
+	}
+	type nonNumeric struct {
+		Host string `validate:"min=1"`
+	}
+	type badBound struct {
+		Port int `validate:"max=many"`
+	}
+
+	if err := builderutil.Apply(&unknownRule{}, builderutil.ValidateTags[unknownRule]()); !errors.Is(err, builderutil.ErrInvalidTag) {
+		t.Errorf("Expected ErrInvalidTag for an unknown rule, got %v", err)
+	}
+	if err := builderutil.Apply(&nonNumeric{}, builderutil.ValidateTags[nonNumeric]()); !errors.Is(err, builderutil.ErrInvalidTag) {
+		t.Errorf("Expected ErrInvalidTag for a non-numeric field, got %v", err)
+	}
+	if err := builderutil.Apply(&badBound{}, builderutil.ValidateTags[badBound]()); !errors.Is(err, builderutil.ErrInvalidTag) {
+		t.Errorf("Expected ErrInvalidTag for a malformed bound, got %v", err)
+	}
+}
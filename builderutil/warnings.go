@@ -0,0 +1,126 @@
+package builderutil
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrWarningsInUse is returned by BuildWithWarnings when its Warnings is already collecting
+// for another running build.
+var ErrWarningsInUse = errors.New("builderutil: warnings collector used by a running build")
+
+// Warnings collects the non-fatal warnings reported by options adapted with WithWarnings.
+// Each build that wants its warnings reported separately should use its own Warnings, which
+// is passed explicitly to the options and to BuildWithWarnings. The zero value is an empty
+// collector ready to use. A Warnings is safe for concurrent use.
+//
+// The collector is explicit because an option function only receives the target, and looking
+// the collector up from the target cannot tell concurrent builds apart when they share an
+// address, as every build of a zero-size type does, nor reach options that run on staging
+// copies of the target.
+type Warnings struct {
+	mu       sync.Mutex
+	messages []string
+	building bool
+}
+
+// Warn records a warning. Calling Warn on a nil *Warnings discards the message.
+// Parameters:
+// - msg: The warning to record.
+func (w *Warnings) Warn(msg string) {
+
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.messages = append(w.messages, msg)
+}
+
+// Messages returns the warnings recorded so far.
+//
+// Returns:
+// - A copy of the warnings in the order they were reported, or nil if there are none.
+func (w *Warnings) Messages() []string {
+
+	if w == nil {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return append([]string(nil), w.messages...)
+}
+
+// WithWarnings adapts a configuration function that can report non-fatal warnings, such as a
+// deprecated setting or a fallback being used, into the standard option shape. fn receives a
+// warn function in addition to the target; calling it records a message in w without aborting
+// the build, while returning an error still fails the build as usual. Because the collector is
+// passed explicitly, warnings are recorded whichever entry point applies the option, including
+// staging copies such as the one of BuildWithTimeout. A nil w discards every warning.
+// Parameters:
+// - w: The collector recording the warnings.
+// - fn: The configuration function receiving the warn function.
+//
+// Returns:
+// - A configuration function calling fn with the Warn method of w.
+func WithWarnings[T any](w *Warnings, fn func(*T, func(string)) error) func(*T) error {
+	return func(t *T) error {
+		return fn(t, w.Warn)
+	}
+}
+
+// BuildWithWarnings is like BuildContext but also returns the warnings reported to w by options
+// adapted with WithWarnings, keeping advisory messages separate from hard failures:
+//
+//	var w builderutil.Warnings
+//	cfg, warnings, err := builderutil.BuildWithWarnings(ctx, &w, builderutil.Options[Config]{
+//		builderutil.WithWarnings(&w, setLegacyPort),
+//	})
+//
+// Only the warnings reported while this build runs are returned. A collector serves one build
+// at a time: if w is already used by a running BuildWithWarnings, the build is refused with
+// ErrWarningsInUse, and sequential builds may reuse it. A nil w collects nothing.
+// Parameters:
+// - ctx: The context that controls cancellation of the build.
+// - w: The collector passed to the options adapted with WithWarnings.
+// - opts: Variadic arguments of type Lister[T] that provide configuration functions.
+//
+// Returns:
+// - A pointer to the newly constructed instance of T.
+// - The warnings in the order they were reported, including those reported before a failure.
+// - ErrWarningsInUse, or an error if the context is done or any configuration function fails.
+func BuildWithWarnings[T any](ctx context.Context, w *Warnings, opts ...Lister[T]) (*T, []string, error) {
+
+	if w == nil {
+		t, err := BuildContext(ctx, opts...)
+		return t, nil, err
+	}
+
+	w.mu.Lock()
+	if w.building {
+		w.mu.Unlock()
+		return nil, nil, ErrWarningsInUse
+	}
+	w.building = true
+	before := len(w.messages)
+	w.mu.Unlock()
+
+	t, err := BuildContext(ctx, opts...)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.building = false
+
+	var warnings []string
+	if len(w.messages) > before {
+		warnings = append(warnings, w.messages[before:]...)
+	}
+
+	return t, warnings, err
+}
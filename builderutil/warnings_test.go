@@ -0,0 +1,174 @@
+package builderutil_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// warningsConfig is a configuration type used by the warning tests.
+type warningsConfig struct {
+	Port int
+}
+
+// TestBuildWithWarnings tests if warnings accumulate and are returned alongside a successful build.
+func TestBuildWithWarnings(t *testing.T) {
+	var w builderutil.Warnings
+
+	deprecated := builderutil.WithWarnings(&w, func(c *warningsConfig, warn func(string)) error {
+		warn("Port is deprecated")
+		c.Port = 8080
+		return nil
+	})
+	fallback := builderutil.WithWarnings(&w, func(c *warningsConfig, warn func(string)) error {
+		warn("using fallback")
+		return nil
+	})
+
+	config, warnings, err := builderutil.BuildWithWarnings[warningsConfig](context.Background(), &w, builderutil.Options[warningsConfig]{deprecated, fallback})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Port != 8080 {
+		t.Errorf("Expected config.Port to be 8080, got %d", config.Port)
+	}
+
+	expected := []string{"Port is deprecated", "using fallback"}
+	if !reflect.DeepEqual(warnings, expected) {
+		t.Errorf("Expected warnings %q, got %q", expected, warnings)
+	}
+}
+
+// TestBuildWithWarnings_Error tests if warnings reported before a failure are still returned.
+func TestBuildWithWarnings_Error(t *testing.T) {
+	errFailed := errors.New("error in function")
+
+	var w builderutil.Warnings
+
+	failing := builderutil.WithWarnings(&w, func(_ *warningsConfig, warn func(string)) error {
+		warn("about to fail")
+		return errFailed
+	})
+
+	config, warnings, err := builderutil.BuildWithWarnings[warningsConfig](context.Background(), &w, builderutil.Options[warningsConfig]{failing})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if config != nil {
+		t.Errorf("Expected nil config, got %+v", config)
+	}
+	if len(warnings) != 1 || warnings[0] != "about to fail" {
+		t.Errorf("Expected the warning to be returned, got %q", warnings)
+	}
+}
+
+// TestBuildWithWarnings_Concurrent tests if concurrent builds of a zero-size type keep their warnings apart.
+func TestBuildWithWarnings_Concurrent(t *testing.T) {
+	const builds = 8
+
+	results := make([][]string, builds)
+
+	var wg sync.WaitGroup
+	for i := 0; i < builds; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			var w builderutil.Warnings
+			option := builderutil.WithWarnings(&w, func(_ *struct{}, warn func(string)) error {
+				warn(fmt.Sprintf("from%d", i))
+				return nil
+			})
+
+			_, warnings, err := builderutil.BuildWithWarnings[struct{}](context.Background(), &w, builderutil.Options[struct{}]{option})
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			results[i] = warnings
+		}(i)
+	}
+	wg.Wait()
+
+	for i, warnings := range results {
+		expected := []string{fmt.Sprintf("from%d", i)}
+		if !reflect.DeepEqual(warnings, expected) {
+			t.Errorf("Expected warnings %q for build %d, got %q", expected, i, warnings)
+		}
+	}
+}
+
+// TestWithWarnings_Build tests if warnings are recorded by other entry points and discarded for a nil collector.
+func TestWithWarnings_Build(t *testing.T) {
+	var w builderutil.Warnings
+
+	option := builderutil.WithWarnings(&w, func(c *warningsConfig, warn func(string)) error {
+		warn("recorded")
+		c.Port = 8080
+		return nil
+	})
+	discarded := builderutil.WithWarnings(nil, func(_ *warningsConfig, warn func(string)) error {
+		warn("ignored")
+		return nil
+	})
+
+	config, err := builderutil.Build[warningsConfig](builderutil.Options[warningsConfig]{option, discarded})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Port != 8080 {
+		t.Errorf("Expected config.Port to be 8080, got %d", config.Port)
+	}
+
+	if messages := w.Messages(); len(messages) != 1 || messages[0] != "recorded" {
+		t.Errorf("Expected the warning to be recorded, got %q", messages)
+	}
+}
+
+// TestBuildWithWarnings_InUse tests if a collector shared with a running build is refused.
+func TestBuildWithWarnings_InUse(t *testing.T) {
+	var w builderutil.Warnings
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	blocking := builderutil.WithWarnings(&w, func(_ *warningsConfig, warn func(string)) error {
+		warn("first")
+		close(started)
+		<-release
+		return nil
+	})
+
+	done := make(chan []string, 1)
+	go func() {
+		_, warnings, err := builderutil.BuildWithWarnings[warningsConfig](context.Background(), &w, builderutil.Options[warningsConfig]{blocking})
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		done <- warnings
+	}()
+
+	<-started
+
+	_, _, err := builderutil.BuildWithWarnings[warningsConfig](context.Background(), &w)
+	if !errors.Is(err, builderutil.ErrWarningsInUse) {
+		t.Errorf("Expected ErrWarningsInUse, got %v", err)
+	}
+
+	close(release)
+
+	if warnings := <-done; !reflect.DeepEqual(warnings, []string{"first"}) {
+		t.Errorf("Expected warnings [\"first\"], got %q", warnings)
+	}
+
+	if _, _, err := builderutil.BuildWithWarnings[warningsConfig](context.Background(), &w); err != nil {
+		t.Errorf("Expected the collector to be reusable, got %v", err)
+	}
+}
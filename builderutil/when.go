@@ -0,0 +1,82 @@
+package builderutil
+
+import (
+	"context"
+	"os"
+)
+
+// When returns fn if cond is true and a no-op function otherwise.
+// The condition is evaluated immediately, when the option is created.
+// Parameters:
+// - cond: Whether fn should be applied.
+// - fn: The configuration function to apply when cond is true.
+//
+// Returns:
+// - fn, or a function that returns nil without touching the instance of T.
+func When[T any](cond bool, fn func(*T) error) func(*T) error {
+
+	if !cond || fn == nil {
+		return Noop[T]()
+	}
+
+	return fn
+}
+
+// WhenFunc is like When but evaluates cond lazily, each time the option is applied.
+// A nil cond is treated as false.
+// Parameters:
+// - cond: A predicate evaluated at apply time to decide whether fn runs.
+// - fn: The configuration function to apply when cond returns true.
+//
+// Returns:
+// - A function that calls fn only if cond returns true.
+func WhenFunc[T any](cond func() bool, fn func(*T) error) func(*T) error {
+	return func(t *T) error {
+
+		if cond == nil || fn == nil || !cond() {
+			return nil
+		}
+
+		return fn(t)
+	}
+}
+
+// WhenTarget is like WhenFunc but decides based on the partially-built target, which enables
+// data-driven option trees such as applying TLS options only if a port was set by an earlier
+// option. pred is evaluated once, each time the option is applied, against the state left by
+// the preceding options; if it returns true, the functions of opts are applied inline, in
+// order, with the same rules as Build. A nil pred is treated as false.
+// Parameters:
+// - pred: A predicate evaluated against the in-progress target.
+// - opts: Variadic Listers applied when pred returns true.
+//
+// Returns:
+// - A configuration function returning a *BuildError, indexed within opts, for the failing function.
+func WhenTarget[T any](pred func(*T) bool, opts ...Lister[T]) func(*T) error {
+
+	opts = append([]Lister[T](nil), opts...)
+
+	return func(t *T) error {
+
+		if pred == nil || !pred(t) {
+			return nil
+		}
+
+		return apply(context.Background(), t, opts, nil)
+	}
+}
+
+// WhenEnv returns a configuration function that calls fn only if the environment variable key
+// equals value, which keeps dev/prod differentiation out of the options themselves. The
+// variable is read each time the option is applied, not when WhenEnv is called. An unset
+// variable compares as an empty string, like os.Getenv.
+// Parameters:
+// - key: The name of the environment variable, such as "APP_ENV".
+// - value: The value the variable must have for fn to run.
+// - fn: The configuration function to apply on a match.
+//
+// Returns:
+// - A function that calls fn only if the environment variable matches.
+func WhenEnv[T any](key, value string, fn func(*T) error) func(*T) error {
+	return WhenFunc[T](func() bool { return os.Getenv(key) == value }, fn)
+}
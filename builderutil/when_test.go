@@ -0,0 +1,180 @@
+package builderutil_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// TestWhen tests if When applies the function only when the condition is true.
+func TestWhen(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	calls := 0
+	setValue := func(value int) func(*Config) error {
+		return func(c *Config) error {
+			calls++
+			c.Value = value
+			return nil
+		}
+	}
+
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{
+		builderutil.When(true, setValue(42)),
+		builderutil.When(false, setValue(7)),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Verify that only the function with a true condition ran
+	if config.Value != 42 {
+		t.Errorf("Expected config.Value to be 42, got %d", config.Value)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+}
+
+// TestWhenFunc tests if WhenFunc evaluates the predicate lazily at apply time.
+func TestWhenFunc(t *testing.T) {
+	type Config struct {
+		Value int
+	}
+
+	calls := 0
+	setValue := func(c *Config) error {
+		calls++
+		c.Value = 42
+		return nil
+	}
+
+	enabled := false
+	option := builderutil.WhenFunc(func() bool { return enabled }, setValue)
+
+	// The predicate is false at the time of the first build
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{option})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Value != 0 || calls != 0 {
+		t.Errorf("Expected the function not to be called, got %d calls", calls)
+	}
+
+	// The same option applies once the predicate becomes true
+	enabled = true
+	config, err = builderutil.Build[Config](builderutil.Options[Config]{option})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Value != 42 || calls != 1 {
+		t.Errorf("Expected the function to be called once, got %d calls", calls)
+	}
+}
+
+// TestWhenTarget tests if sub-options apply only when the predicate holds against the in-progress target.
+func TestWhenTarget(t *testing.T) {
+	type Config struct {
+		Port int
+		TLS  bool
+	}
+
+	setPort := func(c *Config) error {
+		c.Port = 443
+		return nil
+	}
+	enableTLS := builderutil.Options[Config]{func(c *Config) error {
+		c.TLS = true
+		return nil
+	}}
+	hasPort := func(c *Config) bool {
+		return c.Port != 0
+	}
+
+	config, err := builderutil.Build[Config](builderutil.Options[Config]{
+		builderutil.WhenTarget(hasPort, enableTLS),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.TLS {
+		t.Errorf("Expected TLS to stay disabled without a port")
+	}
+
+	config, err = builderutil.Build[Config](builderutil.Options[Config]{
+		setPort,
+		builderutil.WhenTarget(hasPort, enableTLS),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !config.TLS {
+		t.Errorf("Expected TLS to be enabled once a port is set")
+	}
+}
+
+// TestWhenTarget_Error tests if a failing sub-option fails the enclosing build.
+func TestWhenTarget_Error(t *testing.T) {
+	type Config struct{}
+
+	errFailed := errors.New("error in function")
+
+	errFunc := builderutil.Options[Config]{func(*Config) error {
+		return errFailed
+	}}
+	always := func(*Config) bool {
+		return true
+	}
+
+	_, err := builderutil.Build[Config](builderutil.Options[Config]{builderutil.WhenTarget(always, errFunc)})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Expected %v, got %v", errFailed, err)
+	}
+
+	if _, err := builderutil.Build[Config](builderutil.Options[Config]{builderutil.WhenTarget(nil, errFunc)}); err != nil {
+		t.Errorf("Expected a nil predicate to skip the sub-options, got %v", err)
+	}
+}
+
+// TestWhenEnv tests if WhenEnv applies the function only when the environment variable matches at apply time.
+func TestWhenEnv(t *testing.T) {
+	type Config struct {
+		Debug bool
+	}
+
+	option := builderutil.WhenEnv("BUILDERUTIL_TEST_ENV", "dev", func(c *Config) error {
+		c.Debug = true
+		return nil
+	})
+
+	tests := []struct {
+		value    string
+		set      bool
+		expected bool
+	}{
+		{value: "dev", set: true, expected: true},
+		{value: "prod", set: true, expected: false},
+		{set: false, expected: false},
+	}
+
+	for _, test := range tests {
+		if test.set {
+			t.Setenv("BUILDERUTIL_TEST_ENV", test.value)
+		} else {
+			os.Unsetenv("BUILDERUTIL_TEST_ENV")
+		}
+
+		config, err := builderutil.Build[Config](builderutil.Options[Config]{option})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if config.Debug != test.expected {
+			t.Errorf("Expected Debug to be %v for %q (set: %v), got %v", test.expected, test.value, test.set, config.Debug)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/zeroxsolutions/go-utils/builderutil/yamlconfig
+
+go 1.21
+
+require (
+	github.com/zeroxsolutions/go-utils v0.1.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,24 @@
+// Package yamlconfig provides a builderutil Lister that loads configuration from YAML.
+// It lives in its own module so that the core builderutil package stays dependency-free.
+package yamlconfig
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+)
+
+// FromYAML returns a Lister that applies the YAML document data onto the target.
+// Only the fields present in the document are changed, including inside nested structs.
+// Unmarshal errors are reported as an error from the option function during Build, in which
+// case the target is left untouched.
+// Parameters:
+// - data: The YAML document to decode.
+//
+// Returns:
+// - A Lister whose single function decodes data onto the target.
+func FromYAML[T any](data []byte) builderutil.Lister[T] {
+	return builderutil.FromDecoder[T](func(v any) error {
+		return yaml.Unmarshal(data, v)
+	})
+}
@@ -0,0 +1,79 @@
+package yamlconfig_test
+
+import (
+	"testing"
+
+	"github.com/zeroxsolutions/go-utils/builderutil"
+	"github.com/zeroxsolutions/go-utils/builderutil/yamlconfig"
+)
+
+// Config is a configuration type with a nested struct loaded from YAML.
+type Config struct {
+	Name     string   `yaml:"name"`
+	Server   Server   `yaml:"server"`
+	Features []string `yaml:"features"`
+}
+
+// Server is the nested part of Config.
+type Server struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+}
+
+// TestFromYAML tests if FromYAML populates fields, including nested structs.
+func TestFromYAML(t *testing.T) {
+	data := []byte(`
+name: api
+server:
+  host: localhost
+  port: 8080
+features:
+  - metrics
+  - tracing
+`)
+
+	config, err := builderutil.Build[Config](yamlconfig.FromYAML[Config](data))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Name != "api" {
+		t.Errorf("Expected config.Name to be api, got %s", config.Name)
+	}
+	if config.Server.Host != "localhost" || config.Server.Port != 8080 {
+		t.Errorf("Expected server localhost:8080, got %+v", config.Server)
+	}
+	if len(config.Features) != 2 || config.Features[1] != "tracing" {
+		t.Errorf("Expected features [metrics tracing], got %v", config.Features)
+	}
+}
+
+// TestFromYAML_Partial tests if a partial document keeps values set by earlier options.
+func TestFromYAML_Partial(t *testing.T) {
+	setDefaults := func(c *Config) error {
+		c.Name = "default"
+		c.Server = Server{Host: "0.0.0.0", Port: 80}
+		return nil
+	}
+
+	config, err := builderutil.Build[Config](
+		builderutil.Options[Config]{setDefaults},
+		yamlconfig.FromYAML[Config]([]byte("server:\n  port: 8080\n")),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := Server{Host: "0.0.0.0", Port: 8080}
+	if config.Name != "default" || config.Server != expected {
+		t.Errorf("Expected default name and server %+v, got %+v", expected, *config)
+	}
+}
+
+// TestFromYAML_Invalid tests if an unmarshal error surfaces during Build.
+func TestFromYAML_Invalid(t *testing.T) {
+	_, err := builderutil.Build[Config](yamlconfig.FromYAML[Config]([]byte("server: [unclosed")))
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}